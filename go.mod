@@ -13,6 +13,7 @@ require (
 	github.com/containerd/containerd v1.5.2 // indirect
 	github.com/cosmos/cosmos-sdk v0.44.0
 	github.com/cosmos/go-bip39 v1.0.0
+	github.com/cosmos/ibc-go v1.2.0
 	github.com/docker/docker v20.10.7+incompatible
 	github.com/emicklei/proto v1.9.0
 	github.com/fatih/color v1.10.0
@@ -26,6 +27,7 @@ require (
 	github.com/gobuffalo/plush v3.8.3+incompatible
 	github.com/gobuffalo/plushgen v0.1.2
 	github.com/goccy/go-yaml v1.9.2
+	github.com/gogo/protobuf v1.3.3
 	github.com/google/go-github/v37 v37.0.0
 	github.com/gookit/color v1.2.7
 	github.com/gorilla/mux v1.8.0
@@ -43,6 +45,7 @@ require (
 	github.com/otiai10/copy v1.6.0
 	github.com/pelletier/go-toml v1.9.3
 	github.com/pkg/errors v0.9.1
+	github.com/prometheus/client_golang v1.11.0
 	github.com/radovskyb/watcher v1.0.7
 	github.com/rogpeppe/go-internal v1.7.0 // indirect
 	github.com/rs/cors v1.7.0
@@ -169,6 +169,37 @@ func TestGenerateAStargateAppWithEmptyModule(t *testing.T) {
 		)),
 	))
 
+	env.Must(env.Exec("create a module with dependency keeper methods",
+		step.NewSteps(step.New(
+			step.Exec(
+				"starport",
+				"s",
+				"module",
+				"with_dep_methods",
+				"--dep",
+				"bank:SendCoins,GetBalance,staking:GetValidator",
+				"--require-registration",
+			),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("should prevent creating a module with an unknown dependency keeper method",
+		step.NewSteps(step.New(
+			step.Exec(
+				"starport",
+				"s",
+				"module",
+				"with_wrong_dep_method",
+				"--dep",
+				"bank:Unknown",
+				"--require-registration",
+			),
+			step.Workdir(path),
+		)),
+		envtest.ExecShouldError(),
+	))
+
 	env.Must(env.Exec("should prevent creating a module with invalid dependencies",
 		step.NewSteps(step.New(
 			step.Exec(
@@ -201,5 +232,65 @@ func TestGenerateAStargateAppWithEmptyModule(t *testing.T) {
 		envtest.ExecShouldError(),
 	))
 
+	env.Must(env.Exec("create a module with validated params",
+		step.NewSteps(step.New(
+			step.Exec(
+				"starport",
+				"s",
+				"module",
+				"with_params",
+				"--params",
+				"maxFoo:uint:min=1:max=100,denom:string:regex=^[a-z]+$,fee:coin:nonempty",
+				"--require-registration",
+			),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("add params to an existing module",
+		step.NewSteps(step.New(
+			step.Exec(
+				"starport",
+				"s",
+				"params",
+				"enabled:bool:default=true",
+				"fees:coins",
+				"--module",
+				"with_params",
+			),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("should prevent adding an existing param",
+		step.NewSteps(step.New(
+			step.Exec(
+				"starport",
+				"s",
+				"params",
+				"maxFoo:uint",
+				"--module",
+				"with_params",
+			),
+			step.Workdir(path),
+		)),
+		envtest.ExecShouldError(),
+	))
+
+	env.Must(env.Exec("should prevent adding a param with invalid rules",
+		step.NewSteps(step.New(
+			step.Exec(
+				"starport",
+				"s",
+				"params",
+				"name:string:min=1",
+				"--module",
+				"with_params",
+			),
+			step.Workdir(path),
+		)),
+		envtest.ExecShouldError(),
+	))
+
 	env.EnsureAppIsSteady(path)
 }
@@ -292,6 +292,53 @@ func TestCreateIBCPacket(t *testing.T) {
 		)),
 	))
 
+	env.Must(env.Exec("create an ordered IBC module with the bank dependency",
+		step.NewSteps(step.New(
+			step.Exec(
+				"starport",
+				"s",
+				"module",
+				"escrow",
+				"--ibc",
+				"--ordering",
+				"ordered",
+				"--dep",
+				"bank",
+				"--require-registration",
+			),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("create a packet refunding its coins with a custom timeout",
+		step.NewSteps(step.New(
+			step.Exec(
+				"starport",
+				"s",
+				"packet",
+				"transfer",
+				"amount:coin",
+				"fees:coins",
+				"memo",
+				"--ack",
+				"received:bool",
+				"--timeout",
+				"5m",
+				"--module",
+				"escrow",
+			),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("should prevent creating a packet with a negative timeout",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "packet", "late", "text", "--timeout", "-5m", "--module", "escrow"),
+			step.Workdir(path),
+		)),
+		envtest.ExecShouldError(),
+	))
+
 	env.Must(env.Exec("create a non-IBC module",
 		step.NewSteps(step.New(
 			step.Exec("starport", "s", "module", "bar", "--require-registration"),
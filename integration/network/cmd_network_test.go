@@ -0,0 +1,74 @@
+//go:build !relayer
+// +build !relayer
+
+package network_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/starport/integration"
+	"github.com/tendermint/starport/starport/pkg/cmdrunner/step"
+	"github.com/tendermint/starport/starport/pkg/cosmosaccount"
+)
+
+func TestNetworkPublishJoinApprovePrepare(t *testing.T) {
+	var (
+		env             = envtest.New(t)
+		path            = env.Scaffold("mars")
+		testNetwork     = env.TmpDir()
+		coordinatorHome = env.TmpDir()
+		validatorHome   = env.TmpDir()
+		testNetworkEnv  = step.Env("STARPORT_TEST_NETWORK=" + testNetwork)
+		networkCommand  = func(args ...string) step.Steps {
+			return step.NewSteps(step.New(
+				step.Exec("starport", append([]string{"network"}, args...)...),
+				testNetworkEnv,
+			))
+		}
+	)
+
+	env.Must(env.Exec("publish the chain",
+		networkCommand("chain", "publish", path, "--from", "alice", "--home", coordinatorHome),
+	))
+
+	env.Must(env.Exec("join the chain as a validator",
+		networkCommand(
+			"chain", "join", "0",
+			"--from", "bob",
+			"--home", validatorHome,
+			"--self-delegation", "95000000stake",
+			"--amount", "100000000stake",
+			"--peer-address", "127.0.0.1:26656",
+		),
+	))
+
+	env.Must(env.Exec("a request can only be approved by the coordinator",
+		networkCommand("request", "approve", "0", "0-1", "--from", "bob"),
+		envtest.ExecShouldError(),
+	))
+
+	env.Must(env.Exec("approve the requests of the validator",
+		networkCommand("request", "approve", "0", "0-1", "--from", "alice"),
+	))
+
+	env.Must(env.Exec("prepare the genesis",
+		networkCommand("chain", "prepare", "0", "--from", "bob", "--home", validatorHome),
+	))
+
+	// the genesis contains the account and the gentx of the validator.
+	registry, err := cosmosaccount.New(
+		cosmosaccount.WithHome(filepath.Join(testNetwork, "accounts")),
+		cosmosaccount.WithKeyringBackend(cosmosaccount.KeyringTest),
+	)
+	require.NoError(t, err)
+	bob, err := registry.GetByName("bob")
+	require.NoError(t, err)
+
+	genesis, err := os.ReadFile(filepath.Join(validatorHome, "config", "genesis.json"))
+	require.NoError(t, err)
+	require.Contains(t, string(genesis), bob.Address("cosmos"))
+	require.Contains(t, string(genesis), "95000000")
+}
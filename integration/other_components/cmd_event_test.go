@@ -0,0 +1,69 @@
+//go:build !relayer
+// +build !relayer
+
+package other_components_test
+
+import (
+	"testing"
+
+	"github.com/tendermint/starport/integration"
+	"github.com/tendermint/starport/starport/pkg/cmdrunner/step"
+)
+
+func TestGenerateAnAppWithEvents(t *testing.T) {
+	var (
+		env  = envtest.New(t)
+		path = env.Scaffold("blog")
+	)
+
+	env.Must(env.Exec("create a message that emits an event",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "message", "do-foo", "text", "vote:int", "like:bool", "--events"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("create a message with a custom signer that emits an event",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "message", "do-bar", "bar", "--signer", "bar-doer", "--events"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("create a list that emits events",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "list", "post", "title", "body", "--events"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("create a map that emits events",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "map", "vote", "option", "--index", "voter", "--events"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("create a singleton that emits events",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "single", "config", "paused:bool", "--events"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("create a module",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "module", "forum"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("create a message that emits an event in the module",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "message", "close-thread", "id:uint", "--module", "forum", "--events"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.EnsureAppIsSteady(path)
+}
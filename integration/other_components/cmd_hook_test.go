@@ -0,0 +1,64 @@
+//go:build !relayer
+// +build !relayer
+
+package other_components_test
+
+import (
+	"testing"
+
+	"github.com/tendermint/starport/integration"
+	"github.com/tendermint/starport/starport/pkg/cmdrunner/step"
+)
+
+func TestGenerateAnAppWithHooks(t *testing.T) {
+	var (
+		env  = envtest.New(t)
+		path = env.Scaffold("blog")
+	)
+
+	env.Must(env.Exec("create a begin block hook",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "hook", "begin"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("create an end block hook",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "hook", "end"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("should prevent creating an existing hook",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "hook", "begin"),
+			step.Workdir(path),
+		)),
+		envtest.ExecShouldError(),
+	))
+
+	env.Must(env.Exec("create a module",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "module", "forum"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("create a hook in the module",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "hook", "end", "--module", "forum"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("should prevent creating a hook in a non existent module",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "hook", "begin", "--module", "foo"),
+			step.Workdir(path),
+		)),
+		envtest.ExecShouldError(),
+	))
+
+	env.EnsureAppIsSteady(path)
+}
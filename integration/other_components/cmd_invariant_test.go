@@ -0,0 +1,64 @@
+//go:build !relayer
+// +build !relayer
+
+package other_components_test
+
+import (
+	"testing"
+
+	"github.com/tendermint/starport/integration"
+	"github.com/tendermint/starport/starport/pkg/cmdrunner/step"
+)
+
+func TestGenerateAnAppWithInvariants(t *testing.T) {
+	var (
+		env  = envtest.New(t)
+		path = env.Scaffold("blog")
+	)
+
+	env.Must(env.Exec("create an invariant",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "invariant", "positive-balance"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("create a second invariant",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "invariant", "total-supply"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("should prevent creating an existing invariant",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "invariant", "positive-balance"),
+			step.Workdir(path),
+		)),
+		envtest.ExecShouldError(),
+	))
+
+	env.Must(env.Exec("create a module",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "module", "forum"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("create an invariant in the module",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "invariant", "positive-balance", "--module", "forum"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("should prevent creating an invariant in a non existent module",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "invariant", "positive-balance", "--module", "foo"),
+			step.Workdir(path),
+		)),
+		envtest.ExecShouldError(),
+	))
+
+	env.EnsureAppIsSteady(path)
+}
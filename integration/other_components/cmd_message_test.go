@@ -127,3 +127,56 @@ func TestGenerateAnAppWithMessage(t *testing.T) {
 
 	env.EnsureAppIsSteady(path)
 }
+
+func TestGenerateAnAppWithAuthzMessage(t *testing.T) {
+	var (
+		env  = envtest.New(t)
+		path = env.Scaffold("blog", "--authz")
+	)
+
+	env.Must(env.Exec("create a message executable with authz",
+		step.NewSteps(step.New(
+			step.Exec(
+				"starport",
+				"s",
+				"message",
+				"do-foo",
+				"text",
+				"--authz",
+			),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("create a message with a typed authorization",
+		step.NewSteps(step.New(
+			step.Exec(
+				"starport",
+				"s",
+				"message",
+				"do-bar",
+				"text",
+				"vote:int",
+				"--authorization",
+			),
+			step.Workdir(path),
+		)),
+	))
+
+	env.EnsureAppIsSteady(path)
+}
+
+func TestGenerateAnAppWithAuthzMessageWithoutAuthz(t *testing.T) {
+	var (
+		env  = envtest.New(t)
+		path = env.Scaffold("blog")
+	)
+
+	env.Must(env.Exec("should prevent creating a message executable with authz",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "message", "do-foo", "text", "--authz"),
+			step.Workdir(path),
+		)),
+		envtest.ExecShouldError(),
+	))
+}
@@ -142,5 +142,75 @@ func TestGenerateAnAppWithQuery(t *testing.T) {
 		)),
 	))
 
+	env.Must(env.Exec("create a list and a singleton to query",
+		step.NewSteps(
+			step.New(
+				step.Exec("starport", "s", "list", "post", "title", "--module", "foo"),
+				step.Workdir(path),
+			),
+			step.New(
+				step.Exec("starport", "s", "single", "config", "value", "--module", "foo"),
+				step.Workdir(path),
+			),
+		),
+	))
+
+	env.Must(env.Exec("create a query returning stored types",
+		step.NewSteps(step.New(
+			step.Exec(
+				"starport",
+				"s",
+				"query",
+				"latestPosts",
+				"author",
+				"--module",
+				"foo",
+				"--response",
+				"posts:array.Post,postsById:map.Post,config:Config",
+				"--paginated",
+				"--rest-route",
+				"posts/{author}",
+			),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("create a query with a post REST endpoint",
+		step.NewSteps(step.New(
+			step.Exec(
+				"starport",
+				"s",
+				"query",
+				"searchPosts",
+				"title",
+				"--module",
+				"foo",
+				"--response",
+				"posts:array.Post",
+				"--http-method",
+				"post",
+			),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("should prevent creating a query with an unknown route parameter",
+		step.NewSteps(step.New(
+			step.Exec(
+				"starport",
+				"s",
+				"query",
+				"wrongRoute",
+				"title",
+				"--module",
+				"foo",
+				"--rest-route",
+				"/posts/{unknown}",
+			),
+			step.Workdir(path),
+		)),
+		envtest.ExecShouldError(),
+	))
+
 	env.EnsureAppIsSteady(path)
 }
@@ -0,0 +1,64 @@
+//go:build !relayer
+// +build !relayer
+
+package other_components_test
+
+import (
+	"testing"
+
+	"github.com/tendermint/starport/integration"
+	"github.com/tendermint/starport/starport/pkg/cmdrunner/step"
+)
+
+func TestGenerateAnAppWithUpgrades(t *testing.T) {
+	var (
+		env  = envtest.New(t)
+		path = env.Scaffold("blog")
+	)
+
+	env.Must(env.Exec("create an upgrade for the app's module",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "upgrade", "v2"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("create a module",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "module", "forum"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("add a migration of another module to the same upgrade",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "upgrade", "v2", "--module", "forum"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("create a second upgrade for the module",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "upgrade", "v3", "--module", "forum"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("should prevent creating an upgrade with an invalid name",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "upgrade", "v3/1"),
+			step.Workdir(path),
+		)),
+		envtest.ExecShouldError(),
+	))
+
+	env.Must(env.Exec("should prevent creating an upgrade for a non existent module",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "upgrade", "v4", "--module", "foo"),
+			step.Workdir(path),
+		)),
+		envtest.ExecShouldError(),
+	))
+
+	env.EnsureAppIsSteady(path)
+}
@@ -0,0 +1,88 @@
+//go:build !relayer
+// +build !relayer
+
+package simulation_test
+
+import (
+	"testing"
+
+	"github.com/tendermint/starport/integration"
+	"github.com/tendermint/starport/starport/pkg/cmdrunner/step"
+	"github.com/tendermint/starport/starport/pkg/gocmd"
+)
+
+func TestSimulateAppWithStargate(t *testing.T) {
+	var (
+		env  = envtest.New(t)
+		path = env.Scaffold("blog")
+	)
+
+	env.Must(env.Exec("create a list",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "list", "post", "title", "body", "votes:uint"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("create a map",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "map", "vote", "option", "--index", "voter"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("create a singleton",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "single", "config", "paused:bool"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("create a message",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "message", "like-post", "id:uint"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("create a module",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "module", "forum"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("create a list in the module",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "list", "thread", "title", "--module", "forum"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.Must(env.Exec("create a message in the module",
+		step.NewSteps(step.New(
+			step.Exec("starport", "s", "message", "close-thread", "id:uint", "--module", "forum"),
+			step.Workdir(path),
+		)),
+	))
+
+	env.EnsureAppIsSteady(path)
+
+	env.Must(env.Exec("run the simulation of the app",
+		step.NewSteps(step.New(
+			step.Exec(
+				gocmd.Name(),
+				"test",
+				"./app",
+				"-run",
+				"TestFullAppSimulation",
+				"-Enabled=true",
+				"-NumBlocks=20",
+				"-BlockSize=50",
+				"-Commit=true",
+				"-Seed=42",
+			),
+			step.Workdir(path),
+		)),
+	))
+}
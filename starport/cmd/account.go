@@ -1,14 +1,17 @@
 package starportcmd
 
 import (
+	"bufio"
 	"fmt"
 	"os"
+	"strings"
 	"text/tabwriter"
 
 	"github.com/spf13/cobra"
 	flag "github.com/spf13/pflag"
 	"github.com/tendermint/starport/starport/pkg/cliquiz"
 	"github.com/tendermint/starport/starport/pkg/cosmosaccount"
+	"github.com/tendermint/starport/starport/pkg/cosmosclient"
 )
 
 const (
@@ -17,6 +20,17 @@ const (
 	flagNonInteractive = "non-interactive"
 	flagKeyringBackend = "keyring-backend"
 	flagFrom           = "from"
+
+	flagKeyringPassphraseFD = "keyring-passphrase-fd"
+	flagAccountNumber       = "account-number"
+	flagAddressIndex        = "index"
+	flagHDPath              = "hd-path"
+	flagNode                = "node"
+
+	defaultNodeAddress = "http://localhost:26657"
+
+	// envKeyringPassphrase is the env var to read the passphrase of the file keyring backend from.
+	envKeyringPassphrase = "STARPORT_KEYRING_PASSPHRASE"
 )
 
 func NewAccount() *cobra.Command {
@@ -35,6 +49,12 @@ Starport uses accounts to interact with the Starport Network blockchain, use an
 	c.AddCommand(NewAccountList())
 	c.AddCommand(NewAccountImport())
 	c.AddCommand(NewAccountExport())
+	c.AddCommand(NewAccountDerive())
+	c.AddCommand(NewAccountSync())
+	c.AddCommand(NewAccountRegister())
+	c.AddCommand(NewAccountBalance())
+	c.AddCommand(NewAccountSend())
+	c.AddCommand(NewAccountTxs())
 
 	return c
 }
@@ -47,13 +67,19 @@ func printAccounts(cmd *cobra.Command, accounts ...cosmosaccount.Account) {
 		return
 	}
 
-	fmt.Fprintln(w, "name\taddress\tpublic key")
+	fmt.Fprintln(w, "name\taddress\tpublic key\thd path")
 
 	for _, acc := range accounts {
-		fmt.Fprintf(w, "%s\t%s\t%s\n",
+		hdPath := acc.HDPath
+		if hdPath == "" {
+			hdPath = "-"
+		}
+
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n",
 			acc.Name,
 			acc.Address(getAddressPrefix(cmd)),
 			acc.PubKey(),
+			hdPath,
 		)
 	}
 
@@ -63,15 +89,89 @@ func printAccounts(cmd *cobra.Command, accounts ...cosmosaccount.Account) {
 
 func flagSetKeyringBackend() *flag.FlagSet {
 	fs := flag.NewFlagSet("", flag.ContinueOnError)
-	fs.String(flagKeyringBackend, "test", "Keyring backend to store your account keys")
+	fs.String(flagKeyringBackend, "test", "Keyring backend to store your account keys (test|os|file)")
+	fs.Int(flagKeyringPassphraseFD, -1, fmt.Sprintf(
+		"File descriptor to read the file keyring passphrase from (defaults to $%s or a prompt)",
+		envKeyringPassphrase,
+	))
 	return fs
 }
 
+func flagSetRemoteSigner() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String(flagRemoteSigner, "", "Address of a remote signing service to sign transactions with")
+	return fs
+}
+
+// getSigner returns the remote signer set by flags, nil means that keys of the keyring are used.
+func getSigner(cmd *cobra.Command) cosmosclient.Signer {
+	address, _ := cmd.Flags().GetString(flagRemoteSigner)
+	if address == "" {
+		return nil
+	}
+	return cosmosclient.NewRemoteSigner(address)
+}
+
 func getKeyringBackend(cmd *cobra.Command) cosmosaccount.KeyringBackend {
 	backend, _ := cmd.Flags().GetString(flagKeyringBackend)
 	return cosmosaccount.KeyringBackend(backend)
 }
 
+// getKeyringPassphrase returns the passphrase to unlock the file keyring backend.
+// an empty passphrase means that it needs to be prompted by the keyring.
+func getKeyringPassphrase(cmd *cobra.Command) (string, error) {
+	if getKeyringBackend(cmd) != cosmosaccount.KeyringFile {
+		return "", nil
+	}
+
+	return readKeyringPassphrase(cmd)
+}
+
+// passphraseFromFD is the passphrase read from the file descriptor given by flags, it is kept
+// since the file descriptor can only be read once.
+var passphraseFromFD *string
+
+// readKeyringPassphrase reads the keyring passphrase from the file descriptor given by flags
+// or from the env when there is none.
+func readKeyringPassphrase(cmd *cobra.Command) (string, error) {
+	fd, _ := cmd.Flags().GetInt(flagKeyringPassphraseFD)
+	if fd < 0 {
+		return os.Getenv(envKeyringPassphrase), nil
+	}
+	if passphraseFromFD != nil {
+		return *passphraseFromFD, nil
+	}
+
+	f := os.NewFile(uintptr(fd), flagKeyringPassphraseFD)
+	if f == nil {
+		return "", fmt.Errorf("invalid file descriptor %d", fd)
+	}
+	defer f.Close()
+
+	passphrase, err := bufio.NewReader(f).ReadString('\n')
+	if err != nil && passphrase == "" {
+		return "", fmt.Errorf("cannot read keyring passphrase from file descriptor %d: %w", fd, err)
+	}
+
+	passphrase = strings.TrimRight(passphrase, "\r\n")
+	passphraseFromFD = &passphrase
+
+	return passphrase, nil
+}
+
+// newAccountRegistry creates an account registry configured by the keyring flags.
+func newAccountRegistry(cmd *cobra.Command, options ...cosmosaccount.Option) (cosmosaccount.Registry, error) {
+	passphrase, err := getKeyringPassphrase(cmd)
+	if err != nil {
+		return cosmosaccount.Registry{}, err
+	}
+
+	return cosmosaccount.New(append([]cosmosaccount.Option{
+		cosmosaccount.WithKeyringBackend(getKeyringBackend(cmd)),
+		cosmosaccount.WithKeyringPassphrase(passphrase),
+	}, options...)...)
+}
+
 func flagSetAccountPrefixes() *flag.FlagSet {
 	fs := flag.NewFlagSet("", flag.ContinueOnError)
 	fs.String(flagAddressPrefix, "cosmos", "Account address prefix")
@@ -88,6 +188,60 @@ func getFrom(cmd *cobra.Command) string {
 	return prefix
 }
 
+func flagSetNode() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String(flagNode, defaultNodeAddress, "RPC address of the chain's node")
+	return fs
+}
+
+func getNode(cmd *cobra.Command) string {
+	node, _ := cmd.Flags().GetString(flagNode)
+	return node
+}
+
+// newAccountClient creates a client to access the chain's node given by flags
+// with the accounts in the keyring given by flags.
+func newAccountClient(cmd *cobra.Command) (cosmosclient.Client, error) {
+	passphrase, err := getKeyringPassphrase(cmd)
+	if err != nil {
+		return cosmosclient.Client{}, err
+	}
+
+	options := []cosmosclient.Option{
+		cosmosclient.WithNodeAddress(getNode(cmd)),
+		cosmosclient.WithAddressPrefix(getAddressPrefix(cmd)),
+		cosmosclient.WithKeyringBackend(getKeyringBackend(cmd)),
+		cosmosclient.WithKeyringPassphrase(passphrase),
+	}
+	if signer := getSigner(cmd); signer != nil {
+		options = append(options, cosmosclient.WithSigner(signer))
+	}
+
+	return cosmosclient.New(cmd.Context(), options...)
+}
+
+func flagSetAccountDerivation() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.Uint32(flagAccountNumber, 0, "Account number of the HD path to derive the key from")
+	fs.Uint32(flagAddressIndex, 0, "Address index of the HD path to derive the key from")
+	fs.String(flagHDPath, "", "Full HD path to derive the key from (e.g. m/44'/118'/0'/0/0), overrides account number and index")
+	return fs
+}
+
+func getAccountOptions(cmd *cobra.Command) []cosmosaccount.AccountOption {
+	var (
+		number, _ = cmd.Flags().GetUint32(flagAccountNumber)
+		index, _  = cmd.Flags().GetUint32(flagAddressIndex)
+		path, _   = cmd.Flags().GetString(flagHDPath)
+	)
+
+	return []cosmosaccount.AccountOption{
+		cosmosaccount.WithAccountNumber(number),
+		cosmosaccount.WithAddressIndex(index),
+		cosmosaccount.WithHDPath(path),
+	}
+}
+
 func flagSetAccountImportExport() *flag.FlagSet {
 	fs := flag.NewFlagSet("", flag.ContinueOnError)
 	fs.Bool(flagNonInteractive, false, "Do not enter into interactive mode")
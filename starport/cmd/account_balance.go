@@ -0,0 +1,59 @@
+package starportcmd
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+)
+
+func NewAccountBalance() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "balance [name]",
+		Short: "Show the balances of an account on a chain",
+		Args:  cobra.ExactArgs(1),
+		RunE:  accountBalanceHandler,
+	}
+
+	c.Flags().AddFlagSet(flagSetNode())
+	c.Flags().AddFlagSet(flagSetKeyringBackend())
+	c.Flags().AddFlagSet(flagSetAccountPrefixes())
+
+	return c
+}
+
+func accountBalanceHandler(cmd *cobra.Command, args []string) error {
+	name := args[0]
+
+	client, err := newAccountClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	acc, err := client.Account(name)
+	if err != nil {
+		return err
+	}
+
+	balances, err := client.BankBalances(cmd.Context(), acc.Address(getAddressPrefix(cmd)))
+	if err != nil {
+		return err
+	}
+
+	if len(balances) == 0 {
+		fmt.Printf("Account %q has no balances.\n", name)
+		return nil
+	}
+
+	w := &tabwriter.Writer{}
+	w.Init(os.Stdout, 0, 8, 0, '\t', 0)
+
+	fmt.Fprintln(w, "amount\tdenom")
+	for _, coin := range balances {
+		fmt.Fprintf(w, "%s\t%s\n", coin.Amount, coin.Denom)
+	}
+
+	fmt.Fprintln(w)
+	return w.Flush()
+}
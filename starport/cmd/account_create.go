@@ -4,7 +4,6 @@ import (
 	"fmt"
 
 	"github.com/spf13/cobra"
-	"github.com/tendermint/starport/starport/pkg/cosmosaccount"
 )
 
 func NewAccountCreate() *cobra.Command {
@@ -16,6 +15,7 @@ func NewAccountCreate() *cobra.Command {
 	}
 
 	c.Flags().AddFlagSet(flagSetKeyringBackend())
+	c.Flags().AddFlagSet(flagSetAccountDerivation())
 
 	return c
 }
@@ -23,14 +23,12 @@ func NewAccountCreate() *cobra.Command {
 func accountCreateHandler(cmd *cobra.Command, args []string) error {
 	name := args[0]
 
-	ca, err := cosmosaccount.New(
-		cosmosaccount.WithKeyringBackend(getKeyringBackend(cmd)),
-	)
+	ca, err := newAccountRegistry(cmd)
 	if err != nil {
 		return err
 	}
 
-	_, mnemonic, err := ca.Create(name)
+	_, mnemonic, err := ca.Create(name, getAccountOptions(cmd)...)
 	if err != nil {
 		return err
 	}
@@ -4,7 +4,6 @@ import (
 	"fmt"
 
 	"github.com/spf13/cobra"
-	"github.com/tendermint/starport/starport/pkg/cosmosaccount"
 )
 
 func NewAccountDelete() *cobra.Command {
@@ -23,9 +22,7 @@ func NewAccountDelete() *cobra.Command {
 func accountDeleteHandler(cmd *cobra.Command, args []string) error {
 	name := args[0]
 
-	ca, err := cosmosaccount.New(
-		cosmosaccount.WithKeyringBackend(getKeyringBackend(cmd)),
-	)
+	ca, err := newAccountRegistry(cmd)
 	if err != nil {
 		return err
 	}
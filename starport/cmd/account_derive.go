@@ -0,0 +1,80 @@
+package starportcmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/tendermint/starport/starport/pkg/cliquiz"
+)
+
+const flagMnemonicFrom = "mnemonic-from"
+
+func NewAccountDerive() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "derive [name]",
+		Short: "Derive a new account from the mnemonic of an existing account",
+		Long: `Derive a new account from the mnemonic of an existing account by using a different HD path.
+
+Keys do not keep their mnemonic, so the mnemonic of the account given with --mnemonic-from
+is asked and verified before the new account is derived. When name is not provided,
+the new account is named as "[mnemonic-from]-[index]".`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: accountDeriveHandler,
+	}
+
+	c.Flags().String(flagMnemonicFrom, "", "Name of the account whose mnemonic is used")
+	c.Flags().String(flagSecret, "", "Mnemonic of the account (use interactive mode instead to securely pass your mnemonic)")
+	c.Flags().AddFlagSet(flagSetKeyringBackend())
+	c.Flags().AddFlagSet(flagSetAccountImportExport())
+	c.Flags().AddFlagSet(flagSetAccountDerivation())
+	c.Flags().AddFlagSet(flagSetAccountPrefixes())
+	c.MarkFlagRequired(flagMnemonicFrom)
+
+	return c
+}
+
+func accountDeriveHandler(cmd *cobra.Command, args []string) error {
+	var (
+		from, _     = cmd.Flags().GetString(flagMnemonicFrom)
+		mnemonic, _ = cmd.Flags().GetString(flagSecret)
+		index, _    = cmd.Flags().GetUint32(flagAddressIndex)
+		name        = fmt.Sprintf("%s-%d", from, index)
+	)
+
+	if len(args) > 0 {
+		name = args[0]
+	}
+
+	if mnemonic == "" {
+		if getIsNonInteractive(cmd) {
+			return errors.New("mnemonic is required in non-interactive mode")
+		}
+		if err := cliquiz.Ask(
+			cliquiz.NewQuestion(fmt.Sprintf("Mnemonic of %q", from),
+				&mnemonic,
+				cliquiz.HideAnswer(),
+				cliquiz.Required(),
+			)); err != nil {
+			return err
+		}
+	}
+
+	passphrase, err := getPassphrase(cmd)
+	if err != nil {
+		return err
+	}
+
+	ca, err := newAccountRegistry(cmd)
+	if err != nil {
+		return err
+	}
+
+	acc, err := ca.Derive(name, from, mnemonic, passphrase, getAccountOptions(cmd)...)
+	if err != nil {
+		return err
+	}
+
+	printAccounts(cmd, acc)
+	return nil
+}
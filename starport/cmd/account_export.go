@@ -6,7 +6,6 @@ import (
 	"path/filepath"
 
 	"github.com/spf13/cobra"
-	"github.com/tendermint/starport/starport/pkg/cosmosaccount"
 )
 
 func NewAccountExport() *cobra.Command {
@@ -35,9 +34,7 @@ func accountExportHandler(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	ca, err := cosmosaccount.New(
-		cosmosaccount.WithKeyringBackend(getKeyringBackend(cmd)),
-	)
+	ca, err := newAccountRegistry(cmd)
 	if err != nil {
 		return err
 	}
@@ -8,7 +8,6 @@ import (
 	"github.com/cosmos/go-bip39"
 	"github.com/spf13/cobra"
 	"github.com/tendermint/starport/starport/pkg/cliquiz"
-	"github.com/tendermint/starport/starport/pkg/cosmosaccount"
 )
 
 const flagSecret = "secret"
@@ -24,6 +23,7 @@ func NewAccountImport() *cobra.Command {
 	c.Flags().String(flagSecret, "", "Your mnemonic or path to your private key (use interactive mode instead to securely pass your mnemonic)")
 	c.Flags().AddFlagSet(flagSetKeyringBackend())
 	c.Flags().AddFlagSet(flagSetAccountImportExport())
+	c.Flags().AddFlagSet(flagSetAccountDerivation())
 
 	return c
 }
@@ -57,14 +57,12 @@ func accountImportHandler(cmd *cobra.Command, args []string) error {
 		secret = string(privKey)
 	}
 
-	ca, err := cosmosaccount.New(
-		cosmosaccount.WithKeyringBackend(getKeyringBackend(cmd)),
-	)
+	ca, err := newAccountRegistry(cmd)
 	if err != nil {
 		return err
 	}
 
-	if _, err := ca.Import(name, secret, passphrase); err != nil {
+	if _, err := ca.Import(name, secret, passphrase, getAccountOptions(cmd)...); err != nil {
 		return err
 	}
 
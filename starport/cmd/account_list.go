@@ -2,7 +2,6 @@ package starportcmd
 
 import (
 	"github.com/spf13/cobra"
-	"github.com/tendermint/starport/starport/pkg/cosmosaccount"
 )
 
 func NewAccountList() *cobra.Command {
@@ -19,9 +18,7 @@ func NewAccountList() *cobra.Command {
 }
 
 func accountListHandler(cmd *cobra.Command, args []string) error {
-	ca, err := cosmosaccount.New(
-		cosmosaccount.WithKeyringBackend(getKeyringBackend(cmd)),
-	)
+	ca, err := newAccountRegistry(cmd)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,53 @@
+package starportcmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/tendermint/starport/starport/pkg/cosmosaccount"
+)
+
+const flagPubKey = "pubkey"
+
+func NewAccountRegister() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "register [name]",
+		Short: "Register a remote account by its public key",
+		Long: `Register a remote account by its public key. Private key of a remote account is kept
+by a remote signing service which is used to sign transactions on behalf of the account.`,
+		Args: cobra.ExactArgs(1),
+		RunE: accountRegisterHandler,
+	}
+
+	c.Flags().String(flagPubKey, "", `Public key in JSON, e.g. {"@type":"/cosmos.crypto.secp256k1.PubKey","key":"..."}`)
+	c.Flags().AddFlagSet(flagSetKeyringBackend())
+	c.Flags().AddFlagSet(flagSetAccountPrefixes())
+	c.MarkFlagRequired(flagPubKey)
+
+	return c
+}
+
+func accountRegisterHandler(cmd *cobra.Command, args []string) error {
+	var (
+		name         = args[0]
+		rawPubKey, _ = cmd.Flags().GetString(flagPubKey)
+	)
+
+	pubKey, err := cosmosaccount.PubKeyFromJSON(rawPubKey)
+	if err != nil {
+		return fmt.Errorf("invalid public key: %w", err)
+	}
+
+	ca, err := newAccountRegistry(cmd)
+	if err != nil {
+		return err
+	}
+
+	acc, err := ca.RegisterRemote(name, pubKey)
+	if err != nil {
+		return err
+	}
+
+	printAccounts(cmd, acc)
+	return nil
+}
@@ -0,0 +1,63 @@
+package starportcmd
+
+import (
+	"fmt"
+
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/spf13/cobra"
+)
+
+func NewAccountSend() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "send [from] [to] [coins]",
+		Short: "Send coins from an account to an account name or an address",
+		Example: `starport account send alice bob 10token
+starport account send alice cosmos1lst6dcza3lqgqtwf7ft6xtkyzydg8ql5w5f5ke 10token,5stake`,
+		Args: cobra.ExactArgs(3),
+		RunE: accountSendHandler,
+	}
+
+	c.Flags().AddFlagSet(flagSetNode())
+	c.Flags().AddFlagSet(flagSetKeyringBackend())
+	c.Flags().AddFlagSet(flagSetAccountPrefixes())
+	c.Flags().AddFlagSet(flagSetRemoteSigner())
+
+	return c
+}
+
+func accountSendHandler(cmd *cobra.Command, args []string) error {
+	var (
+		from     = args[0]
+		to       = args[1]
+		rawCoins = args[2]
+	)
+
+	coins, err := sdktypes.ParseCoinsNormalized(rawCoins)
+	if err != nil {
+		return err
+	}
+
+	client, err := newAccountClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	// to is either an address or the name of an account.
+	toAddress := to
+	if _, _, err := bech32.DecodeAndConvert(to); err != nil {
+		acc, err := client.Account(to)
+		if err != nil {
+			return err
+		}
+		toAddress = acc.Address(getAddressPrefix(cmd))
+	}
+
+	res, err := client.BankSendTx(cmd.Context(), from, toAddress, coins)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Sent %s from %q to %s (tx: %s)\n", coins, from, toAddress, res.TxHash)
+	return nil
+}
@@ -2,7 +2,6 @@ package starportcmd
 
 import (
 	"github.com/spf13/cobra"
-	"github.com/tendermint/starport/starport/pkg/cosmosaccount"
 )
 
 func NewAccountShow() *cobra.Command {
@@ -22,9 +21,7 @@ func NewAccountShow() *cobra.Command {
 func accountShowHandler(cmd *cobra.Command, args []string) error {
 	name := args[0]
 
-	ca, err := cosmosaccount.New(
-		cosmosaccount.WithKeyringBackend(getKeyringBackend(cmd)),
-	)
+	ca, err := newAccountRegistry(cmd)
 	if err != nil {
 		return err
 	}
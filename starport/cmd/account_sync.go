@@ -0,0 +1,155 @@
+package starportcmd
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	"github.com/tendermint/starport/starport/pkg/clispinner"
+	"github.com/tendermint/starport/starport/pkg/cosmosaccount"
+)
+
+const (
+	flagChainKeyringBackend = "chain-keyring-backend"
+	flagChainKeyringService = "chain-keyring-service"
+	flagFromChain           = "from-chain"
+	flagMove                = "move"
+)
+
+func NewAccountSync() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "sync [name]...",
+		Short: "Copy or move accounts between Starport and a chain's home",
+		Long: `Copy or move accounts between Starport's keyring and the keyring of a chain's home.
+
+By default accounts are copied from Starport to the chain. Use --from-chain to copy them
+from the chain to Starport instead. All accounts are synced when no names are given.
+
+The chain's home, keyring backend and keyring service name are detected from the chain
+at --path unless --home is provided. With --home, use --chain-keyring-backend and
+--chain-keyring-service to match the chain's keyring. Keys are converted between keyring
+backends when they differ.`,
+		RunE: accountSyncHandler,
+	}
+
+	flagSetPath(c)
+	c.Flags().AddFlagSet(flagSetHome())
+	c.Flags().AddFlagSet(flagSetKeyringBackend())
+	c.Flags().String(flagChainKeyringBackend, "", "Keyring backend of the chain's home (test|os|file)")
+	c.Flags().String(flagChainKeyringService, "", "Keyring service name of the chain, used by the os backend (default: the chain's name)")
+	c.Flags().Bool(flagFromChain, false, "Sync accounts from the chain to Starport")
+	c.Flags().Bool(flagMove, false, "Delete accounts from the source keyring after they are synced")
+
+	return c
+}
+
+func accountSyncHandler(cmd *cobra.Command, args []string) error {
+	var (
+		fromChain, _ = cmd.Flags().GetBool(flagFromChain)
+		move, _      = cmd.Flags().GetBool(flagMove)
+	)
+
+	home, chainBackend, chainService, err := chainKeyring(cmd)
+	if err != nil {
+		return err
+	}
+
+	var passphrase string
+	if getKeyringBackend(cmd) == cosmosaccount.KeyringFile || chainBackend == cosmosaccount.KeyringFile {
+		if passphrase, err = readKeyringPassphrase(cmd); err != nil {
+			return err
+		}
+	}
+
+	starportRegistry, err := cosmosaccount.New(
+		cosmosaccount.WithKeyringBackend(getKeyringBackend(cmd)),
+		cosmosaccount.WithKeyringPassphrase(passphrase),
+	)
+	if err != nil {
+		return err
+	}
+
+	chainRegistry, err := cosmosaccount.New(
+		cosmosaccount.WithHome(home),
+		cosmosaccount.WithKeyringServiceName(chainService),
+		cosmosaccount.WithKeyringBackend(chainBackend),
+		cosmosaccount.WithKeyringPassphrase(passphrase),
+	)
+	if err != nil {
+		return err
+	}
+
+	src, dst, target := starportRegistry, chainRegistry, home
+	if fromChain {
+		src, dst, target = chainRegistry, starportRegistry, "Starport"
+	}
+
+	names := args
+	if len(names) == 0 {
+		accounts, err := src.List()
+		if err != nil {
+			return err
+		}
+		for _, acc := range accounts {
+			names = append(names, acc.Name)
+		}
+	}
+
+	for _, name := range names {
+		if _, err := src.CopyTo(dst, name); err != nil {
+			return err
+		}
+
+		action := "copied"
+		if move {
+			if err := src.DeleteByName(name); err != nil {
+				return err
+			}
+			action = "moved"
+		}
+
+		fmt.Printf("%s Account %q %s to %s\n", clispinner.OK, name, action, target)
+	}
+
+	return nil
+}
+
+// chainKeyring returns the home, the keyring backend and the keyring service name of the chain's keyring.
+func chainKeyring(cmd *cobra.Command) (home string, backend cosmosaccount.KeyringBackend, service string, err error) {
+	home = getHome(cmd)
+	flagBackend, _ := cmd.Flags().GetString(flagChainKeyringBackend)
+	backend = cosmosaccount.KeyringBackend(flagBackend)
+	service, _ = cmd.Flags().GetString(flagChainKeyringService)
+
+	if home == "" {
+		c, err := newChainWithHomeFlags(cmd)
+		if err != nil {
+			return "", "", "", err
+		}
+
+		if home, err = c.Home(); err != nil {
+			return "", "", "", err
+		}
+
+		if backend == "" {
+			chainBackend, err := c.KeyringBackend()
+			if err != nil {
+				return "", "", "", err
+			}
+			backend = cosmosaccount.KeyringBackend(chainBackend)
+		}
+
+		if service == "" {
+			service = c.KeyringServiceName()
+		}
+	}
+
+	if backend == "" {
+		backend = cosmosaccount.KeyringTest
+	}
+	if service == "" {
+		service = sdk.KeyringServiceName()
+	}
+
+	return home, backend, service, nil
+}
@@ -0,0 +1,65 @@
+package starportcmd
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+)
+
+const flagLimit = "limit"
+
+func NewAccountTxs() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "txs [name]",
+		Short: "Show the transactions sent or received by an account on a chain",
+		Args:  cobra.ExactArgs(1),
+		RunE:  accountTxsHandler,
+	}
+
+	c.Flags().Int(flagLimit, 30, "Maximum number of transactions to show")
+	c.Flags().AddFlagSet(flagSetNode())
+	c.Flags().AddFlagSet(flagSetKeyringBackend())
+	c.Flags().AddFlagSet(flagSetAccountPrefixes())
+
+	return c
+}
+
+func accountTxsHandler(cmd *cobra.Command, args []string) error {
+	var (
+		name     = args[0]
+		limit, _ = cmd.Flags().GetInt(flagLimit)
+	)
+
+	client, err := newAccountClient(cmd)
+	if err != nil {
+		return err
+	}
+
+	acc, err := client.Account(name)
+	if err != nil {
+		return err
+	}
+
+	txs, err := client.TxsByAddress(cmd.Context(), acc.Address(getAddressPrefix(cmd)), limit)
+	if err != nil {
+		return err
+	}
+
+	if len(txs) == 0 {
+		fmt.Printf("No transactions found for account %q.\n", name)
+		return nil
+	}
+
+	w := &tabwriter.Writer{}
+	w.Init(os.Stdout, 0, 8, 0, '\t', 0)
+
+	fmt.Fprintln(w, "height\thash\tcode\tgas used")
+	for _, tx := range txs {
+		fmt.Fprintf(w, "%d\t%s\t%d\t%d\n", tx.Height, tx.Hash, tx.TxResult.Code, tx.TxResult.GasUsed)
+	}
+
+	fmt.Fprintln(w)
+	return w.Flush()
+}
@@ -18,6 +18,7 @@ func NewChain() *cobra.Command {
 	c.AddCommand(NewChainBuild())
 	c.AddCommand(NewChainInit())
 	c.AddCommand(NewChainFaucet())
+	c.AddCommand(NewChainIBCSandbox())
 
 	return c
 }
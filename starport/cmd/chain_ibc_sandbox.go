@@ -0,0 +1,100 @@
+package starportcmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/tendermint/starport/starport/pkg/relayer"
+	"github.com/tendermint/starport/starport/services/chain"
+	"github.com/tendermint/starport/starport/services/ibcsandbox"
+)
+
+const (
+	flagPort    = "port"
+	flagVersion = "version"
+)
+
+// NewChainIBCSandbox creates a new ibc-sandbox command to serve two chains and relay packets in between.
+func NewChainIBCSandbox() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "ibc-sandbox [config-a] [config-b]",
+		Short: "Serve two blockchains locally and relay IBC packets in between",
+		Long: `Serve two blockchains locally and relay IBC packets in between to test IBC modules.
+
+Apps are served from the directories of their config files. Ports of the second chain are
+shifted when they overlap with the first chain's. Relayer accounts are created and funded by
+the validator accounts of the chains, then a channel is linked between the module ports and
+packets are relayed until the command is stopped.`,
+		Example: `  starport chain ibc-sandbox mars/config.yml venus/config.yml --port blog`,
+		Args:    cobra.ExactArgs(2),
+		RunE:    chainIBCSandboxHandler,
+	}
+
+	c.Flags().AddFlagSet(flagSetKeyringBackend())
+	c.Flags().AddFlagSet(flagSetProto3rdParty(""))
+	c.Flags().BoolP("verbose", "v", false, "Verbose output")
+	c.Flags().BoolP(flagResetOnce, "r", false, "Reset of the app states on first start")
+	c.Flags().String(flagPort, relayer.TransferPort, "IBC port ID of the module on both chains")
+	c.Flags().String(flagVersion, "", "Version of the module on both chains (default: ics20-1 for transfer, <port>-1 otherwise)")
+	c.Flags().Bool(flagOrdered, false, "Set the channel as ordered")
+
+	return c
+}
+
+func chainIBCSandboxHandler(cmd *cobra.Command, args []string) (err error) {
+	defer func() {
+		err = handleRelayerAccountErr(err)
+	}()
+
+	ca, err := newAccountRegistry(cmd)
+	if err != nil {
+		return err
+	}
+
+	var (
+		port, _    = cmd.Flags().GetString(flagPort)
+		version, _ = cmd.Flags().GetString(flagVersion)
+		ordered, _ = cmd.Flags().GetBool(flagOrdered)
+		reset, _   = cmd.Flags().GetBool(flagResetOnce)
+	)
+
+	if version == "" {
+		version = relayer.TransferVersion
+		if port != relayer.TransferPort {
+			version = fmt.Sprintf("%s-1", port)
+		}
+	}
+
+	chainOptions := []chain.Option{
+		chain.LogLevel(logLevel(cmd)),
+	}
+	if flagGetProto3rdParty(cmd) {
+		chainOptions = append(chainOptions, chain.EnableThirdPartyModuleCodegen())
+	}
+
+	var serveOptions []chain.ServeOption
+	if reset {
+		serveOptions = append(serveOptions, chain.ServeResetOnce())
+	}
+
+	channelOptions := []relayer.ChannelOption{
+		relayer.SourcePort(port),
+		relayer.SourceVersion(version),
+		relayer.TargetPort(port),
+		relayer.TargetVersion(version),
+	}
+	if ordered {
+		channelOptions = append(channelOptions, relayer.Ordered())
+	}
+
+	sandbox, err := ibcsandbox.New(ca, args[0], args[1],
+		ibcsandbox.ChainOptions(chainOptions...),
+		ibcsandbox.ServeOptions(serveOptions...),
+		ibcsandbox.ChannelOptions(channelOptions...),
+	)
+	if err != nil {
+		return err
+	}
+
+	return sandbox.Run(cmd.Context())
+}
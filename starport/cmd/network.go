@@ -2,6 +2,8 @@ package starportcmd
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
 
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
@@ -9,6 +11,7 @@ import (
 	"github.com/tendermint/starport/starport/pkg/cosmosclient"
 	"github.com/tendermint/starport/starport/pkg/gitpod"
 	"github.com/tendermint/starport/starport/services/network"
+	"github.com/tendermint/starport/starport/services/network/networktest"
 )
 
 var (
@@ -18,6 +21,8 @@ var (
 	spnNodeAddress   string
 	spnAPIAddress    string
 	spnFaucetAddress string
+	remoteSigner     string
+	testNetwork      string
 )
 
 const (
@@ -27,6 +32,11 @@ const (
 	flagSPNNodeAddress   = "spn-node-address"
 	flagSPNAPIAddress    = "spn-api-address"
 	flagSPNFaucetAddress = "spn-faucet-address"
+	flagRemoteSigner     = "remote-signer"
+	flagTestNetwork      = "test-network"
+
+	// envTestNetwork is used instead of the test network flag when the flag is not set.
+	envTestNetwork = "STARPORT_TEST_NETWORK"
 
 	spnNodeAddressAlpha   = "https://rpc.alpha.starport.network:443"
 	spnAPIAddressAlpha    = "https://rest.alpha.starport.network"
@@ -58,9 +68,13 @@ func NewNetwork() *cobra.Command {
 	c.PersistentFlags().StringVar(&spnNodeAddress, flagSPNNodeAddress, spnNodeAddressAlpha, "SPN node address")
 	c.PersistentFlags().StringVar(&spnAPIAddress, flagSPNAPIAddress, spnAPIAddressAlpha, "SPN api address")
 	c.PersistentFlags().StringVar(&spnFaucetAddress, flagSPNFaucetAddress, spnFaucetAddressAlpha, "SPN Faucet address")
+	c.PersistentFlags().StringVar(&remoteSigner, flagRemoteSigner, "", "Address of a remote signing service to sign SPN transactions with")
+	c.PersistentFlags().StringVar(&testNetwork, flagTestNetwork, "", "Directory of an in-process test network to use instead of SPN (env: "+envTestNetwork+")")
+	c.PersistentFlags().MarkHidden(flagTestNetwork)
 
 	// add sub commands.
 	c.AddCommand(NewNetworkChain())
+	c.AddCommand(NewNetworkRequest())
 
 	return c
 }
@@ -68,6 +82,13 @@ func NewNetwork() *cobra.Command {
 var cosmos *cosmosclient.Client
 
 func newNetwork(cmd *cobra.Command, options ...network.Option) (*network.Builder, error) {
+	if testNetwork == "" {
+		testNetwork = os.Getenv(envTestNetwork)
+	}
+	if testNetwork != "" {
+		return newTestNetwork(cmd, testNetwork, options...)
+	}
+
 	// check preconfigured networks
 	if nightly && local {
 		return nil, errors.New("local and nightly networks can't be specified in the same command")
@@ -101,6 +122,18 @@ func newNetwork(cmd *cobra.Command, options ...network.Option) (*network.Builder
 	}
 	cosmosOptions = append(cosmosOptions, cosmosclient.WithKeyringBackend(keyringBackend))
 
+	if remoteSigner != "" {
+		cosmosOptions = append(cosmosOptions, cosmosclient.WithSigner(cosmosclient.NewRemoteSigner(remoteSigner)))
+	}
+
+	if keyringBackend == cosmosaccount.KeyringFile {
+		passphrase, err := getKeyringPassphrase(cmd)
+		if err != nil {
+			return nil, err
+		}
+		cosmosOptions = append(cosmosOptions, cosmosclient.WithKeyringPassphrase(passphrase))
+	}
+
 	// init cosmos client only once on start in order to spnclient to
 	// reuse unlocked keyring in the following steps.
 	if cosmos == nil {
@@ -120,7 +153,36 @@ func newNetwork(cmd *cobra.Command, options ...network.Option) (*network.Builder
 		return nil, errors.Wrap(err, "make sure that this account exists, use 'starport account -h' to manage accounts")
 	}
 
-	return network.New(*cosmos, account, options...)
+	return network.New(network.WrapCosmosClient(*cosmos), account, options...)
+}
+
+// newTestNetwork creates a network builder that uses the in-process test network saved in dir
+// instead of SPN. accounts of the test network are kept in dir with the test keyring backend
+// and they are created when they don't exist.
+func newTestNetwork(cmd *cobra.Command, dir string, options ...network.Option) (*network.Builder, error) {
+	registry, err := cosmosaccount.New(
+		cosmosaccount.WithHome(filepath.Join(dir, "accounts")),
+		cosmosaccount.WithKeyringBackend(cosmosaccount.KeyringTest),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	ln, err := networktest.Open(filepath.Join(dir, "state.json"), registry)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := registry.GetByName(getFrom(cmd))
+	var accErr *cosmosaccount.AccountDoesNotExistError
+	if errors.As(err, &accErr) {
+		account, _, err = registry.Create(getFrom(cmd))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return network.New(ln, account, options...)
 }
 
 func printSection(title string) {
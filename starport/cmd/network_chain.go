@@ -1,6 +1,9 @@
 package starportcmd
 
-import "github.com/spf13/cobra"
+import (
+	"github.com/spf13/cobra"
+	"github.com/tendermint/starport/starport/services/network"
+)
 
 // NewNetworkChain creates a new chain command that holds some other
 // sub commands related to launching a network for a chain.
@@ -10,7 +13,25 @@ func NewNetworkChain() *cobra.Command {
 		Short: "Build networks",
 	}
 
+	c.AddCommand(NewNetworkChainList())
+	c.AddCommand(NewNetworkChainShow())
 	c.AddCommand(NewNetworkChainPublish())
+	c.AddCommand(NewNetworkChainJoin())
+	c.AddCommand(NewNetworkChainPrepare())
 
 	return c
 }
+
+// initOptionsForLaunch returns the init options for the chain with launchID. chains are initialized
+// under the spn home by default to not overwrite the homes of other chains.
+func initOptionsForLaunch(cmd *cobra.Command, launchID uint64) ([]network.InitOption, error) {
+	if getHome(cmd) != "" {
+		return initOptionWithHomeFlag(cmd, nil), nil
+	}
+
+	home, err := network.ChainHome(launchID)
+	if err != nil {
+		return nil, err
+	}
+	return []network.InitOption{network.InitializationHomePath(home)}, nil
+}
@@ -0,0 +1,141 @@
+package starportcmd
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	"github.com/tendermint/starport/starport/pkg/clispinner"
+	"github.com/tendermint/starport/starport/pkg/cosmosaccount"
+	"github.com/tendermint/starport/starport/pkg/events"
+	"github.com/tendermint/starport/starport/services/network"
+)
+
+const (
+	flagSelfDelegation = "self-delegation"
+	flagAmount         = "amount"
+	flagPeerAddress    = "peer-address"
+	flagMoniker        = "moniker"
+)
+
+// NewNetworkChainJoin returns a new command to join a chain as a validator before its launch.
+func NewNetworkChainJoin() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "join [launch-id]",
+		Short: "Request to join a chain as a validator before its launch",
+		Long: `Request to join a chain as a validator before its launch.
+
+The chain is built and initialized from its source code locked on SPN. A gentx that self
+delegates to the validator is generated from your Starport account, then requests to add the
+account to the genesis and to add the validator are sent to the coordinator of the chain.`,
+		Args: cobra.ExactArgs(1),
+		RunE: networkChainJoinHandler,
+	}
+
+	c.Flags().String(flagSelfDelegation, "", "Coins to self delegate to the validator, e.g. 95000000stake")
+	c.Flags().String(flagAmount, "", "Coins requested for the genesis account of the validator (default: the self delegation)")
+	c.Flags().String(flagPeerAddress, "", "Public address of the validator node for the other peers, e.g. 1.2.3.4:26656")
+	c.Flags().String(flagMoniker, "", "Moniker of the validator")
+	c.Flags().String(flagFrom, cosmosaccount.DefaultAccount, "Account name to use for sending transactions to SPN")
+	c.Flags().AddFlagSet(flagSetKeyringBackend())
+	c.Flags().AddFlagSet(flagSetHome())
+
+	return c
+}
+
+func networkChainJoinHandler(cmd *cobra.Command, args []string) error {
+	var (
+		selfDelegation, _ = cmd.Flags().GetString(flagSelfDelegation)
+		amount, _         = cmd.Flags().GetString(flagAmount)
+		peerAddress, _    = cmd.Flags().GetString(flagPeerAddress)
+		moniker, _        = cmd.Flags().GetString(flagMoniker)
+	)
+
+	launchID, err := parseLaunchID(args[0])
+	if err != nil {
+		return err
+	}
+
+	if selfDelegation == "" || peerAddress == "" {
+		return fmt.Errorf("--%s and --%s are required", flagSelfDelegation, flagPeerAddress)
+	}
+
+	delegation, err := sdk.ParseCoinNormalized(selfDelegation)
+	if err != nil {
+		return fmt.Errorf("invalid self delegation: %w", err)
+	}
+
+	var joinOptions []network.JoinOption
+	if amount != "" {
+		coins, err := sdk.ParseCoinsNormalized(amount)
+		if err != nil {
+			return fmt.Errorf("invalid amount: %w", err)
+		}
+		if !coins.IsAllGTE(sdk.NewCoins(delegation)) {
+			return errors.New("amount must cover the self delegation")
+		}
+		joinOptions = append(joinOptions, network.WithAccountCoins(coins))
+	}
+	if moniker != "" {
+		joinOptions = append(joinOptions, network.WithMoniker(moniker))
+	}
+
+	s := clispinner.New()
+	defer s.Stop()
+
+	var (
+		wg sync.WaitGroup
+		ev = events.NewBus()
+	)
+	wg.Add(1)
+
+	defer wg.Wait()
+	defer ev.Shutdown()
+
+	go printEvents(&wg, ev, s)
+
+	nb, err := newNetwork(cmd, network.CollectEvents(ev))
+	if err != nil {
+		return err
+	}
+
+	chain, err := nb.ChainLaunch(cmd.Context(), launchID)
+	if err != nil {
+		return err
+	}
+
+	initOptions, err := initOptionsForLaunch(cmd, launchID)
+	if err != nil {
+		return err
+	}
+
+	// the account is copied to the chain's keyring to sign the gentx. like with account sync,
+	// the same passphrase unlocks the chain's keyring when it uses the file backend.
+	passphrase, err := readKeyringPassphrase(cmd)
+	if err != nil {
+		return err
+	}
+	initOptions = append(initOptions, network.InitializationKeyringPassphrase(passphrase))
+
+	blockchain, err := nb.Blockchain(cmd.Context(), network.SourceLaunch(chain), initOptions...)
+	if err != nil {
+		return err
+	}
+
+	if err := blockchain.Join(cmd.Context(), launchID, delegation, peerAddress, joinOptions...); err != nil {
+		return err
+	}
+
+	s.Stop()
+
+	home, err := blockchain.Home()
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s Requested to join %s as a validator, the node is initialized at %s\n",
+		clispinner.OK, chain.GenesisChainID, home)
+	return nil
+}
@@ -0,0 +1,65 @@
+package starportcmd
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/tendermint/starport/starport/pkg/clispinner"
+	"github.com/tendermint/starport/starport/pkg/cosmosaccount"
+)
+
+// NewNetworkChainList returns a new command to list the chains published on SPN.
+func NewNetworkChainList() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "list",
+		Short: "List published chains",
+		Args:  cobra.NoArgs,
+		RunE:  networkChainListHandler,
+	}
+
+	c.Flags().String(flagFrom, cosmosaccount.DefaultAccount, "Account name to use for querying SPN")
+	c.Flags().AddFlagSet(flagSetKeyringBackend())
+
+	return c
+}
+
+func networkChainListHandler(cmd *cobra.Command, args []string) error {
+	s := clispinner.New().SetText("Querying chains...")
+	defer s.Stop()
+
+	nb, err := newNetwork(cmd)
+	if err != nil {
+		return err
+	}
+
+	chains, err := nb.ChainLaunches(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	s.Stop()
+
+	if len(chains) == 0 {
+		fmt.Println("No chains published.")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 1, ' ', tabwriter.TabIndent)
+	defer w.Flush()
+
+	fmt.Fprintln(w, "launch id\tchain id\tsource\tcoordinator id\tlaunched")
+
+	for _, chain := range chains {
+		fmt.Fprintf(w, "%d\t%s\t%s\t%d\t%t\n",
+			chain.Id,
+			chain.GenesisChainID,
+			chain.SourceURL,
+			chain.CoordinatorID,
+			chain.LaunchTriggered,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,110 @@
+package starportcmd
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/spf13/cobra"
+	"github.com/tendermint/starport/starport/pkg/clispinner"
+	"github.com/tendermint/starport/starport/pkg/cosmosaccount"
+	"github.com/tendermint/starport/starport/pkg/events"
+	"github.com/tendermint/starport/starport/services/network"
+)
+
+const (
+	flagGenesisHash = "genesis-hash"
+)
+
+// NewNetworkChainPrepare returns a new command to prepare the genesis of a chain from its approved requests.
+func NewNetworkChainPrepare() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "prepare [launch-id]",
+		Short: "Prepare the genesis of a chain from its approved requests",
+		Long: `Prepare the genesis of a chain from its approved requests.
+
+The genesis is built from the genesis published on SPN by applying the approved genesis accounts,
+vesting accounts and gentxs in the order of their addresses, so every validator prepares the same
+genesis without trusting a genesis shared by the coordinator. Keys of the node are kept if the
+chain is already initialized, e.g. by "starport network chain join".
+
+Accounts are requested with their SPN addresses, they are added to the genesis with the address
+prefix of the chain. Only delayed vesting accounts are supported, preparing fails if any other
+type of vesting account is approved.
+
+The prepared genesis is only verified when --genesis-hash is given, use it to check the
+prepared genesis against the hash shared by the coordinator or other validators.`,
+		Args: cobra.ExactArgs(1),
+		RunE: networkChainPrepareHandler,
+	}
+
+	c.Flags().String(flagGenesisHash, "", "Expected hash of the prepared genesis, the genesis is not verified when empty")
+	c.Flags().String(flagFrom, cosmosaccount.DefaultAccount, "Account name to use for querying SPN")
+	c.Flags().AddFlagSet(flagSetKeyringBackend())
+	c.Flags().AddFlagSet(flagSetHome())
+
+	return c
+}
+
+func networkChainPrepareHandler(cmd *cobra.Command, args []string) error {
+	genesisHash, _ := cmd.Flags().GetString(flagGenesisHash)
+
+	launchID, err := parseLaunchID(args[0])
+	if err != nil {
+		return err
+	}
+
+	s := clispinner.New()
+	defer s.Stop()
+
+	var (
+		wg sync.WaitGroup
+		ev = events.NewBus()
+	)
+	wg.Add(1)
+
+	defer wg.Wait()
+	defer ev.Shutdown()
+
+	go printEvents(&wg, ev, s)
+
+	nb, err := newNetwork(cmd, network.CollectEvents(ev))
+	if err != nil {
+		return err
+	}
+
+	chain, err := nb.ChainLaunch(cmd.Context(), launchID)
+	if err != nil {
+		return err
+	}
+
+	initOptions, err := initOptionsForLaunch(cmd, launchID)
+	if err != nil {
+		return err
+	}
+
+	blockchain, err := nb.Blockchain(cmd.Context(), network.SourceLaunch(chain), initOptions...)
+	if err != nil {
+		return err
+	}
+
+	var prepareOptions []network.PrepareOption
+	if genesisHash != "" {
+		prepareOptions = append(prepareOptions, network.WithExpectedGenesisHash(genesisHash))
+	}
+
+	hash, err := blockchain.Prepare(cmd.Context(), launchID, prepareOptions...)
+	if err != nil {
+		return err
+	}
+
+	s.Stop()
+
+	home, err := blockchain.Home()
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s Genesis of %s prepared at %s\n", clispinner.OK, chain.GenesisChainID, home)
+	fmt.Printf("Genesis hash: %s\n", hash)
+	return nil
+}
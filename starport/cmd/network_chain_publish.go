@@ -9,7 +9,6 @@ import (
 	"github.com/tendermint/starport/starport/pkg/clispinner"
 	"github.com/tendermint/starport/starport/pkg/cosmosaccount"
 	"github.com/tendermint/starport/starport/pkg/events"
-	"github.com/tendermint/starport/starport/pkg/xurl"
 	"github.com/tendermint/starport/starport/services/network"
 )
 
@@ -77,17 +76,15 @@ func networkChainPublishHandler(cmd *cobra.Command, args []string) error {
 
 	var sourceOption network.SourceOption
 
-	if !xurl.IsLocalPath(source) {
-		switch {
-		case tag != "":
-			sourceOption = network.SourceRemoteTag(source, tag)
-		case branch != "":
-			sourceOption = network.SourceRemoteBranch(source, branch)
-		case hash != "":
-			sourceOption = network.SourceRemoteHash(source, hash)
-		default:
-			sourceOption = network.SourceRemote(source)
-		}
+	switch {
+	case tag != "":
+		sourceOption = network.SourceRemoteTag(source, tag)
+	case branch != "":
+		sourceOption = network.SourceRemoteBranch(source, branch)
+	case hash != "":
+		sourceOption = network.SourceRemoteHash(source, hash)
+	default:
+		sourceOption = network.SourceRemote(source)
 	}
 
 	// init the chain.
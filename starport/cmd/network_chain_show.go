@@ -0,0 +1,79 @@
+package starportcmd
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/tendermint/starport/starport/pkg/clispinner"
+	"github.com/tendermint/starport/starport/pkg/cosmosaccount"
+)
+
+// NewNetworkChainShow returns a new command to show the details of a chain published on SPN.
+func NewNetworkChainShow() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "show [launch-id]",
+		Short: "Show details of a published chain",
+		Args:  cobra.ExactArgs(1),
+		RunE:  networkChainShowHandler,
+	}
+
+	c.Flags().String(flagFrom, cosmosaccount.DefaultAccount, "Account name to use for querying SPN")
+	c.Flags().AddFlagSet(flagSetKeyringBackend())
+
+	return c
+}
+
+func networkChainShowHandler(cmd *cobra.Command, args []string) error {
+	launchID, err := parseLaunchID(args[0])
+	if err != nil {
+		return err
+	}
+
+	s := clispinner.New().SetText("Querying the chain...")
+	defer s.Stop()
+
+	nb, err := newNetwork(cmd)
+	if err != nil {
+		return err
+	}
+
+	chain, err := nb.ChainLaunch(cmd.Context(), launchID)
+	if err != nil {
+		return err
+	}
+
+	requests, err := nb.Requests(cmd.Context(), launchID)
+	if err != nil {
+		return err
+	}
+
+	s.Stop()
+
+	genesis := "default"
+	if genesisURL := chain.InitialGenesis.GetGenesisURL(); genesisURL != nil {
+		genesis = fmt.Sprintf("%s (hash: %s)", genesisURL.Url, genesisURL.Hash)
+	}
+
+	launched := "no"
+	if chain.LaunchTriggered {
+		launched = time.Unix(chain.LaunchTimestamp, 0).Format(time.RFC3339)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 1, ' ', tabwriter.TabIndent)
+	defer w.Flush()
+
+	fmt.Fprintf(w, "launch id:\t%d\n", chain.Id)
+	fmt.Fprintf(w, "chain id:\t%s\n", chain.GenesisChainID)
+	fmt.Fprintf(w, "coordinator id:\t%d\n", chain.CoordinatorID)
+	fmt.Fprintf(w, "source:\t%s\n", chain.SourceURL)
+	fmt.Fprintf(w, "hash:\t%s\n", chain.SourceHash)
+	fmt.Fprintf(w, "genesis:\t%s\n", genesis)
+	fmt.Fprintf(w, "created at:\t%s\n", time.Unix(chain.CreatedAt, 0).Format(time.RFC3339))
+	fmt.Fprintf(w, "launched:\t%s\n", launched)
+	fmt.Fprintf(w, "pending requests:\t%d\n", len(requests))
+
+	return nil
+}
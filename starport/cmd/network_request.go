@@ -0,0 +1,119 @@
+package starportcmd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/spf13/cobra"
+	"github.com/tendermint/starport/starport/pkg/clispinner"
+	"github.com/tendermint/starport/starport/pkg/events"
+	"github.com/tendermint/starport/starport/services/network"
+)
+
+// NewNetworkRequest creates a new request command that holds some other sub commands
+// related to the requests sent to the chains on SPN.
+func NewNetworkRequest() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "request",
+		Short: "Handle requests of chains",
+	}
+
+	c.AddCommand(NewNetworkRequestList())
+	c.AddCommand(NewNetworkRequestApprove())
+	c.AddCommand(NewNetworkRequestReject())
+
+	return c
+}
+
+// parseLaunchID parses the launch id of a chain on SPN.
+func parseLaunchID(s string) (uint64, error) {
+	launchID, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid launch id %q: %w", s, err)
+	}
+	return launchID, nil
+}
+
+// maxRequestIDRange is the maximum number of ids in a range of request ids.
+const maxRequestIDRange = 1000
+
+// parseRequestIDs parses a comma separated list of request ids where ranges like 3-5 are expanded.
+func parseRequestIDs(s string) ([]uint64, error) {
+	var ids []uint64
+
+	for _, item := range strings.Split(s, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+
+		from, to := item, item
+		if i := strings.Index(item, "-"); i != -1 {
+			from, to = item[:i], item[i+1:]
+		}
+
+		start, err := strconv.ParseUint(from, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid request id %q: %w", item, err)
+		}
+		end, err := strconv.ParseUint(to, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid request id %q: %w", item, err)
+		}
+		if start > end {
+			return nil, fmt.Errorf("invalid request id range %q: end is lower than start", item)
+		}
+		if end-start >= maxRequestIDRange {
+			return nil, fmt.Errorf("invalid request id range %q: ranges can have up to %d ids", item, maxRequestIDRange)
+		}
+
+		// the loop breaks at end instead of going past it, so it doesn't overflow at the max id.
+		for id := start; ; id++ {
+			ids = append(ids, id)
+			if id == end {
+				break
+			}
+		}
+	}
+
+	if len(ids) == 0 {
+		return nil, fmt.Errorf("no request ids in %q", s)
+	}
+
+	return ids, nil
+}
+
+// settleRequests runs settle with a network builder that reports its progress and prints
+// how many requests are settled.
+func settleRequests(cmd *cobra.Command, launchID uint64, requestIDs []uint64, settled string,
+	settle func(*network.Builder) error) error {
+	s := clispinner.New()
+	defer s.Stop()
+
+	var (
+		wg sync.WaitGroup
+		ev = events.NewBus()
+	)
+	wg.Add(1)
+
+	defer wg.Wait()
+	defer ev.Shutdown()
+
+	go printEvents(&wg, ev, s)
+
+	nb, err := newNetwork(cmd, network.CollectEvents(ev))
+	if err != nil {
+		return err
+	}
+
+	if err := settle(nb); err != nil {
+		return err
+	}
+
+	s.Stop()
+
+	fmt.Printf("%s %s %d request(s) of chain %d\n", clispinner.OK, settled, len(requestIDs), launchID)
+	return nil
+}
@@ -0,0 +1,44 @@
+package starportcmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/tendermint/starport/starport/pkg/cosmosaccount"
+	"github.com/tendermint/starport/starport/services/network"
+)
+
+// NewNetworkRequestApprove returns a new command to approve requests of a chain.
+func NewNetworkRequestApprove() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "approve [launch-id] [request-id,...]",
+		Short: "Approve requests of a chain",
+		Long: `Approve requests of a chain as its coordinator.
+
+Request ids are separated with commas and ranges of up to 1000 ids are supported, e.g. 1,3-5.
+All requests are approved in a single transaction, so none of them are approved if one of
+them cannot be.
+Approved requests are applied to the genesis of the chain.`,
+		Args: cobra.ExactArgs(2),
+		RunE: networkRequestApproveHandler,
+	}
+
+	c.Flags().String(flagFrom, cosmosaccount.DefaultAccount, "Account name to use for sending transactions to SPN")
+	c.Flags().AddFlagSet(flagSetKeyringBackend())
+
+	return c
+}
+
+func networkRequestApproveHandler(cmd *cobra.Command, args []string) error {
+	launchID, err := parseLaunchID(args[0])
+	if err != nil {
+		return err
+	}
+
+	requestIDs, err := parseRequestIDs(args[1])
+	if err != nil {
+		return err
+	}
+
+	return settleRequests(cmd, launchID, requestIDs, "Approved", func(nb *network.Builder) error {
+		return nb.ApproveRequests(cmd.Context(), launchID, requestIDs...)
+	})
+}
@@ -0,0 +1,92 @@
+package starportcmd
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+	"github.com/tendermint/starport/starport/pkg/clispinner"
+	"github.com/tendermint/starport/starport/pkg/cosmosaccount"
+)
+
+// NewNetworkRequestList returns a new command to list the pending requests of a chain.
+func NewNetworkRequestList() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "list [launch-id]",
+		Short: "List pending requests of a chain",
+		Args:  cobra.ExactArgs(1),
+		RunE:  networkRequestListHandler,
+	}
+
+	c.Flags().String(flagFrom, cosmosaccount.DefaultAccount, "Account name to use for querying SPN")
+	c.Flags().AddFlagSet(flagSetKeyringBackend())
+
+	return c
+}
+
+func networkRequestListHandler(cmd *cobra.Command, args []string) error {
+	launchID, err := parseLaunchID(args[0])
+	if err != nil {
+		return err
+	}
+
+	s := clispinner.New().SetText("Querying requests...")
+	defer s.Stop()
+
+	nb, err := newNetwork(cmd)
+	if err != nil {
+		return err
+	}
+
+	requests, err := nb.Requests(cmd.Context(), launchID)
+	if err != nil {
+		return err
+	}
+
+	s.Stop()
+
+	if len(requests) == 0 {
+		fmt.Println("No pending requests.")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 1, ' ', tabwriter.TabIndent)
+	defer w.Flush()
+
+	fmt.Fprintln(w, "id\ttype\tcreator\tcreated at\tcontent")
+
+	for _, request := range requests {
+		kind, content := describeRequestContent(request.Content)
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\n",
+			request.RequestID,
+			kind,
+			request.Creator,
+			time.Unix(request.CreatedAt, 0).Format(time.RFC3339),
+			content,
+		)
+	}
+
+	return nil
+}
+
+// describeRequestContent returns the type and a short summary of a request's content.
+func describeRequestContent(content launchtypes.RequestContent) (kind, summary string) {
+	switch c := content.Content.(type) {
+	case *launchtypes.RequestContent_GenesisAccount:
+		return "add account", fmt.Sprintf("%s: %s", c.GenesisAccount.Address, c.GenesisAccount.Coins)
+	case *launchtypes.RequestContent_VestingAccount:
+		return "add vesting account", fmt.Sprintf("%s: %s", c.VestingAccount.Address, c.VestingAccount.StartingBalance)
+	case *launchtypes.RequestContent_GenesisValidator:
+		return "add validator", fmt.Sprintf("%s: %s, peer %s",
+			c.GenesisValidator.Address, c.GenesisValidator.SelfDelegation, c.GenesisValidator.Peer)
+	case *launchtypes.RequestContent_AccountRemoval:
+		return "remove account", c.AccountRemoval.Address
+	case *launchtypes.RequestContent_ValidatorRemoval:
+		return "remove validator", c.ValidatorRemoval.ValAddress
+	default:
+		return "unknown", ""
+	}
+}
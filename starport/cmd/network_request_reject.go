@@ -0,0 +1,44 @@
+package starportcmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/tendermint/starport/starport/pkg/cosmosaccount"
+	"github.com/tendermint/starport/starport/services/network"
+)
+
+// NewNetworkRequestReject returns a new command to reject requests of a chain.
+func NewNetworkRequestReject() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "reject [launch-id] [request-id,...]",
+		Short: "Reject requests of a chain",
+		Long: `Reject requests of a chain as its coordinator.
+
+Request ids are separated with commas and ranges of up to 1000 ids are supported, e.g. 1,3-5.
+All requests are rejected in a single transaction, so none of them are rejected if one of
+them cannot be.
+Rejected requests are removed without changing the genesis of the chain.`,
+		Args: cobra.ExactArgs(2),
+		RunE: networkRequestRejectHandler,
+	}
+
+	c.Flags().String(flagFrom, cosmosaccount.DefaultAccount, "Account name to use for sending transactions to SPN")
+	c.Flags().AddFlagSet(flagSetKeyringBackend())
+
+	return c
+}
+
+func networkRequestRejectHandler(cmd *cobra.Command, args []string) error {
+	launchID, err := parseLaunchID(args[0])
+	if err != nil {
+		return err
+	}
+
+	requestIDs, err := parseRequestIDs(args[1])
+	if err != nil {
+		return err
+	}
+
+	return settleRequests(cmd, launchID, requestIDs, "Rejected", func(nb *network.Builder) error {
+		return nb.RejectRequests(cmd.Context(), launchID, requestIDs...)
+	})
+}
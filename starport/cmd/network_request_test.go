@@ -0,0 +1,51 @@
+package starportcmd
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRequestIDs(t *testing.T) {
+	cases := []struct {
+		name string
+		s    string
+		ids  []uint64
+		err  bool
+	}{
+		{name: "single id", s: "3", ids: []uint64{3}},
+		{name: "list", s: "1, 3,5", ids: []uint64{1, 3, 5}},
+		{name: "range", s: "1,3-5", ids: []uint64{1, 3, 4, 5}},
+		{name: "range of one id", s: "4-4", ids: []uint64{4}},
+		{name: "range at the max id", s: "18446744073709551614-18446744073709551615",
+			ids: []uint64{18446744073709551614, 18446744073709551615}},
+		{name: "largest range", s: "1-1000", ids: idRange(1, 1000)},
+		{name: "empty", s: " , ", err: true},
+		{name: "invalid id", s: "1,a", err: true},
+		{name: "negative id", s: "-1", err: true},
+		{name: "reversed range", s: "5-3", err: true},
+		{name: "too large range", s: "1-1001", err: true},
+		{name: "range to the max id", s: "5-18446744073709551615", err: true},
+		{name: "id overflow", s: "18446744073709551616", err: true},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			ids, err := parseRequestIDs(tt.s)
+			if tt.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.ids, ids)
+		})
+	}
+}
+
+func idRange(start, end uint64) []uint64 {
+	var ids []uint64
+	for id := start; id <= end; id++ {
+		ids = append(ids, id)
+	}
+	return ids
+}
@@ -16,6 +16,10 @@ func NewRelayer() *cobra.Command {
 
 	c.AddCommand(NewRelayerConfigure())
 	c.AddCommand(NewRelayerConnect())
+	c.AddCommand(NewRelayerStatus())
+	c.AddCommand(NewRelayerClients())
+	c.AddCommand(NewRelayerPath())
+	c.AddCommand(NewRelayerChain())
 
 	return c
 }
@@ -0,0 +1,17 @@
+package starportcmd
+
+import "github.com/spf13/cobra"
+
+// NewRelayerChain returns a new relayer chain command to manage the configured chains.
+func NewRelayerChain() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "chain [command]",
+		Short: "Manage the chains configured for relaying",
+		Args:  cobra.ExactArgs(1),
+	}
+
+	c.AddCommand(NewRelayerChainUpdate())
+	c.AddCommand(NewRelayerChainRemove())
+
+	return c
+}
@@ -0,0 +1,39 @@
+package starportcmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	relayerconf "github.com/tendermint/starport/starport/pkg/relayer/config"
+)
+
+// NewRelayerChainRemove returns a new relayer chain remove command.
+func NewRelayerChainRemove() *cobra.Command {
+	return &cobra.Command{
+		Use:     "remove [chain-id]",
+		Short:   "Remove a chain that isn't used by any paths from the relayer config",
+		Aliases: []string{"rm"},
+		Args:    cobra.ExactArgs(1),
+		RunE:    relayerChainRemoveHandler,
+	}
+}
+
+func relayerChainRemoveHandler(cmd *cobra.Command, args []string) error {
+	id := args[0]
+
+	conf, err := relayerconf.Get()
+	if err != nil {
+		return err
+	}
+
+	if err := conf.RemoveChain(id); err != nil {
+		return err
+	}
+
+	if err := relayerconf.Save(conf); err != nil {
+		return err
+	}
+
+	fmt.Printf("Chain %s removed.\n", id)
+	return nil
+}
@@ -0,0 +1,86 @@
+package starportcmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	relayerconf "github.com/tendermint/starport/starport/pkg/relayer/config"
+)
+
+const (
+	flagChainAccount       = "account"
+	flagChainRPC           = "rpc"
+	flagChainGasPrice      = "gasprice"
+	flagChainGasLimit      = "gaslimit"
+	flagChainAddressPrefix = "prefix"
+)
+
+// NewRelayerChainUpdate returns a new relayer chain update command.
+func NewRelayerChainUpdate() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "update [chain-id]",
+		Short: "Update the settings used to relay for a chain",
+		Args:  cobra.ExactArgs(1),
+		RunE:  relayerChainUpdateHandler,
+	}
+
+	c.Flags().String(flagChainAccount, "", "Account used to relay for the chain")
+	c.Flags().String(flagChainRPC, "", "RPC address of the chain")
+	c.Flags().String(flagChainGasPrice, "", "Gas price used for transactions on the chain")
+	c.Flags().Int64(flagChainGasLimit, 0, "Gas limit used for transactions on the chain")
+	c.Flags().String(flagChainAddressPrefix, "", "Address prefix of the chain")
+
+	return c
+}
+
+func relayerChainUpdateHandler(cmd *cobra.Command, args []string) error {
+	conf, err := relayerconf.Get()
+	if err != nil {
+		return err
+	}
+
+	chain, err := conf.ChainByID(args[0])
+	if err != nil {
+		return err
+	}
+
+	var (
+		flags   = cmd.Flags()
+		changed bool
+	)
+
+	for _, f := range []struct {
+		name  string
+		value *string
+	}{
+		{flagChainAccount, &chain.Account},
+		{flagChainRPC, &chain.RPCAddress},
+		{flagChainGasPrice, &chain.GasPrice},
+		{flagChainAddressPrefix, &chain.AddressPrefix},
+	} {
+		if flags.Changed(f.name) {
+			*f.value, _ = flags.GetString(f.name)
+			changed = true
+		}
+	}
+	if flags.Changed(flagChainGasLimit) {
+		chain.GasLimit, _ = flags.GetInt64(flagChainGasLimit)
+		changed = true
+	}
+
+	if !changed {
+		return errors.New("nothing to update, see the flags with --help")
+	}
+
+	if err := conf.UpdateChain(chain); err != nil {
+		return err
+	}
+
+	if err := relayerconf.Save(conf); err != nil {
+		return err
+	}
+
+	fmt.Printf("Chain %s updated.\n", chain.ID)
+	return nil
+}
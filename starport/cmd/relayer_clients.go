@@ -0,0 +1,87 @@
+package starportcmd
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/tendermint/starport/starport/pkg/clispinner"
+	"github.com/tendermint/starport/starport/pkg/relayer"
+)
+
+// NewRelayerClients returns a new relayer clients command to list the IBC clients of all or some paths.
+func NewRelayerClients() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "clients [<path>,...]",
+		Short: "List IBC clients of linked paths with their latest heights and time to expiry",
+		Long: `List IBC clients of linked paths with their latest heights and time to expiry.
+
+Clients expire when they are not updated within their trusting period and paths with
+expired clients cannot be relayed anymore. "starport relayer connect" refreshes clients
+before they expire even if there are no packets to relay.`,
+		RunE: relayerClientsHandler,
+	}
+
+	c.Flags().AddFlagSet(flagSetKeyringBackend())
+
+	return c
+}
+
+func relayerClientsHandler(cmd *cobra.Command, args []string) (err error) {
+	defer func() {
+		err = handleRelayerAccountErr(err)
+	}()
+
+	ca, err := newAccountRegistry(cmd)
+	if err != nil {
+		return err
+	}
+
+	s := clispinner.New().SetText("Querying clients...")
+	defer s.Stop()
+
+	clients, err := relayer.New(ca).Clients(cmd.Context(), args...)
+	if err != nil {
+		return err
+	}
+
+	s.Stop()
+
+	if len(clients) == 0 {
+		fmt.Println("No linked paths found.")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 1, ' ', tabwriter.TabIndent)
+	defer w.Flush()
+
+	fmt.Fprintln(w, "path\tchain\tclient\ttracks\tlatest height\tupdated at\texpires in")
+
+	now := time.Now()
+
+	for _, client := range clients {
+		var expiresIn string
+		switch {
+		case client.ExpiresAt().IsZero():
+			expiresIn = "unknown"
+		case client.IsExpired(now):
+			expiresIn = "expired"
+		default:
+			expiresIn = client.ExpiresAt().Sub(now).Round(time.Second).String()
+		}
+
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%d\t%s\t%s\n",
+			client.PathID,
+			client.ChainID,
+			client.ClientID,
+			client.CounterpartyChainID,
+			client.LatestHeight,
+			client.UpdatedAt.Format(time.RFC3339),
+			expiresIn,
+		)
+	}
+
+	return nil
+}
@@ -32,6 +32,7 @@ const (
 	flagSourceAddressPrefix = "source-prefix"
 	flagTargetAddressPrefix = "target-prefix"
 	flagOrdered             = "ordered"
+	flagRelayerBackend      = "backend"
 
 	relayerSource = "source"
 	relayerTarget = "target"
@@ -75,6 +76,7 @@ func NewRelayerConfigure() *cobra.Command {
 	c.Flags().String(flagSourceAccount, "", "Source Account")
 	c.Flags().String(flagTargetAccount, "", "Target Account")
 	c.Flags().Bool(flagOrdered, false, "Set the channel as ordered")
+	c.Flags().String(flagRelayerBackend, "", "Relayer implementation used to link chains and relay packets (ts-relayer|go), ts-relayer is used by default")
 	c.Flags().AddFlagSet(flagSetKeyringBackend())
 
 	return c
@@ -85,7 +87,7 @@ func relayerConfigureHandler(cmd *cobra.Command, args []string) (err error) {
 		err = handleRelayerAccountErr(err)
 	}()
 
-	ca, err := cosmosaccount.New(cosmosaccount.WithKeyringBackend(getKeyringBackend(cmd)))
+	ca, err := newAccountRegistry(cmd)
 	if err != nil {
 		return err
 	}
@@ -292,6 +294,10 @@ func relayerConfigureHandler(cmd *cobra.Command, args []string) (err error) {
 	if err != nil {
 		return err
 	}
+	backend, err := cmd.Flags().GetString(flagRelayerBackend)
+	if err != nil {
+		return err
+	}
 
 	var questions []cliquiz.Question
 
@@ -356,6 +362,12 @@ func relayerConfigureHandler(cmd *cobra.Command, args []string) (err error) {
 
 	r := relayer.New(ca)
 
+	if backend != "" {
+		if err := r.SetBackend(backend); err != nil {
+			return err
+		}
+	}
+
 	fmt.Println()
 	s.SetText("Fetching chain info...")
 
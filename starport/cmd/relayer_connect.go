@@ -2,15 +2,20 @@ package starportcmd
 
 import (
 	"fmt"
+	"net/http"
 	"os"
 	"text/tabwriter"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/tendermint/starport/starport/pkg/clispinner"
-	"github.com/tendermint/starport/starport/pkg/cosmosaccount"
 	"github.com/tendermint/starport/starport/pkg/relayer"
+	"github.com/tendermint/starport/starport/pkg/xhttp"
+	"golang.org/x/sync/errgroup"
 )
 
+const flagMetricsAddress = "metrics-address"
+
 // NewRelayerConnect returns a new relayer connect command to link all or some relayer paths and start
 // relaying txs in between.
 // if not paths are specified, all paths are linked.
@@ -18,10 +23,16 @@ func NewRelayerConnect() *cobra.Command {
 	c := &cobra.Command{
 		Use:   "connect [<path>,...]",
 		Short: "Link chains associated with paths and start relaying tx packets in between",
-		RunE:  relayerConnectHandler,
+		Long: `Link chains associated with paths and start relaying tx packets in between.
+
+Transactions of the relayer accounts can be signed by a remote signing service with
+--remote-signer. Remote signers are only supported by the go relayer backend.`,
+		RunE: relayerConnectHandler,
 	}
 
 	c.Flags().AddFlagSet(flagSetKeyringBackend())
+	c.Flags().String(flagMetricsAddress, "", "Serve Prometheus metrics at /metrics on the address, e.g. :9090")
+	c.Flags().AddFlagSet(flagSetRemoteSigner())
 
 	return c
 }
@@ -31,7 +42,7 @@ func relayerConnectHandler(cmd *cobra.Command, args []string) (err error) {
 		err = handleRelayerAccountErr(err)
 	}()
 
-	ca, err := cosmosaccount.New(cosmosaccount.WithKeyringBackend(getKeyringBackend(cmd)))
+	ca, err := newAccountRegistry(cmd)
 	if err != nil {
 		return err
 	}
@@ -45,9 +56,33 @@ func relayerConnectHandler(cmd *cobra.Command, args []string) (err error) {
 	s := clispinner.New()
 	defer s.Stop()
 
-	var use []string
+	var (
+		use     []string
+		metrics *relayer.Metrics
+		options []relayer.RelayerOption
+	)
+
+	metricsAddress, _ := cmd.Flags().GetString(flagMetricsAddress)
+	if metricsAddress != "" {
+		metrics = relayer.NewMetrics()
+		options = append(options, relayer.WithMetrics(metrics))
+	}
+
+	if signer := getSigner(cmd); signer != nil {
+		options = append(options, relayer.WithSigner(signer))
+	}
+
+	options = append(options, relayer.WithExpiredClientHandler(func(client relayer.ClientStatus) {
+		fmt.Printf("⚠️  Client %s on %q tracking %q expired at %s, packets of %q cannot be relayed anymore\n",
+			client.ClientID,
+			client.ChainID,
+			client.CounterpartyChainID,
+			client.ExpiresAt().Format(time.RFC3339),
+			client.PathID,
+		)
+	}))
 
-	r := relayer.New(ca)
+	r := relayer.New(ca, options...)
 
 	all, err := r.ListPaths(cmd.Context())
 	if err != nil {
@@ -110,5 +145,21 @@ func relayerConnectHandler(cmd *cobra.Command, args []string) (err error) {
 
 	printSection("Listening and relaying packets between chains...")
 
-	return r.Start(cmd.Context(), use...)
+	if metrics == nil {
+		return r.Start(cmd.Context(), use...)
+	}
+
+	g, ctx := errgroup.WithContext(cmd.Context())
+
+	g.Go(func() error {
+		mux := http.NewServeMux()
+		mux.Handle("/metrics", metrics.Handler())
+
+		return xhttp.Serve(ctx, &http.Server{Addr: metricsAddress, Handler: mux})
+	})
+	g.Go(func() error {
+		return r.Start(ctx, use...)
+	})
+
+	return g.Wait()
 }
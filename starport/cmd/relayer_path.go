@@ -0,0 +1,20 @@
+package starportcmd
+
+import "github.com/spf13/cobra"
+
+// NewRelayerPath returns a new relayer path command to manage the configured paths.
+func NewRelayerPath() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "path [command]",
+		Short: "Manage the paths configured for relaying",
+		Args:  cobra.ExactArgs(1),
+	}
+
+	c.AddCommand(NewRelayerPathShow())
+	c.AddCommand(NewRelayerPathRemove())
+	c.AddCommand(NewRelayerPathRename())
+	c.AddCommand(NewRelayerPathExport())
+	c.AddCommand(NewRelayerPathImport())
+
+	return c
+}
@@ -0,0 +1,60 @@
+package starportcmd
+
+import (
+	"fmt"
+
+	"github.com/goccy/go-yaml"
+	"github.com/spf13/cobra"
+	"github.com/tendermint/starport/starport/pkg/confile"
+	relayerconf "github.com/tendermint/starport/starport/pkg/relayer/config"
+)
+
+const flagOut = "out"
+
+// NewRelayerPathExport returns a new relayer path export command.
+func NewRelayerPathExport() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "export [path],...",
+		Short: "Export paths with their chains to import them to another relayer",
+		Long: `Export paths with their chains to import them to another relayer with "starport relayer path import".
+
+Accounts are exported by their names, they need to be created or imported on the other relayer
+with "starport account" commands.`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: relayerPathExportHandler,
+	}
+
+	c.Flags().StringP(flagOut, "o", "", "File to export to, prints the exported paths when not provided")
+
+	return c
+}
+
+func relayerPathExportHandler(cmd *cobra.Command, args []string) error {
+	out, _ := cmd.Flags().GetString(flagOut)
+
+	conf, err := relayerconf.Get()
+	if err != nil {
+		return err
+	}
+
+	exported, err := conf.Export(args...)
+	if err != nil {
+		return err
+	}
+
+	if out == "" {
+		data, err := yaml.Marshal(exported)
+		if err != nil {
+			return err
+		}
+		fmt.Print(string(data))
+		return nil
+	}
+
+	if err := confile.New(confile.DefaultYAMLEncodingCreator, out).Save(exported); err != nil {
+		return err
+	}
+
+	fmt.Printf("Paths exported to %s.\n", out)
+	return nil
+}
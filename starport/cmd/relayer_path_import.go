@@ -0,0 +1,50 @@
+package starportcmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/tendermint/starport/starport/pkg/confile"
+	relayerconf "github.com/tendermint/starport/starport/pkg/relayer/config"
+)
+
+// NewRelayerPathImport returns a new relayer path import command.
+func NewRelayerPathImport() *cobra.Command {
+	return &cobra.Command{
+		Use:   "import [file]",
+		Short: "Import paths with their chains exported by another relayer",
+		Long: `Import paths with their chains exported by another relayer with "starport relayer path export".
+
+Chains that are already configured are kept as they are.`,
+		Args: cobra.ExactArgs(1),
+		RunE: relayerPathImportHandler,
+	}
+}
+
+func relayerPathImportHandler(cmd *cobra.Command, args []string) error {
+	var imported relayerconf.Config
+	if err := confile.New(confile.DefaultYAMLEncodingCreator, args[0]).Load(&imported); err != nil {
+		return err
+	}
+	if len(imported.Paths) == 0 {
+		return fmt.Errorf("no paths found in %s", args[0])
+	}
+
+	conf, err := relayerconf.Get()
+	if err != nil {
+		return err
+	}
+
+	if err := conf.Import(imported); err != nil {
+		return err
+	}
+
+	if err := relayerconf.Save(conf); err != nil {
+		return err
+	}
+
+	for _, path := range imported.Paths {
+		fmt.Printf("Path %s imported.\n", path.ID)
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+package starportcmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	relayerconf "github.com/tendermint/starport/starport/pkg/relayer/config"
+)
+
+const flagRemoveChains = "remove-chains"
+
+// NewRelayerPathRemove returns a new relayer path remove command.
+func NewRelayerPathRemove() *cobra.Command {
+	c := &cobra.Command{
+		Use:     "remove [path]",
+		Short:   "Remove a path from the relayer config",
+		Long:    "Remove a path from the relayer config. The IBC clients, connection and channel of the path stay on the chains.",
+		Aliases: []string{"rm"},
+		Args:    cobra.ExactArgs(1),
+		RunE:    relayerPathRemoveHandler,
+	}
+
+	c.Flags().Bool(flagRemoveChains, false, "Remove the chains of the path as well when no other paths use them")
+
+	return c
+}
+
+func relayerPathRemoveHandler(cmd *cobra.Command, args []string) error {
+	id := args[0]
+	removeChains, _ := cmd.Flags().GetBool(flagRemoveChains)
+
+	conf, err := relayerconf.Get()
+	if err != nil {
+		return err
+	}
+
+	path, err := conf.PathByID(id)
+	if err != nil {
+		return err
+	}
+
+	if err := conf.RemovePath(id); err != nil {
+		return err
+	}
+
+	if removeChains {
+		for _, chainID := range []string{path.Src.ChainID, path.Dst.ChainID} {
+			if len(conf.ChainPaths(chainID)) > 0 {
+				continue
+			}
+			if _, err := conf.ChainByID(chainID); err != nil { // both ends can be on the same chain.
+				continue
+			}
+			if err := conf.RemoveChain(chainID); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := relayerconf.Save(conf); err != nil {
+		return err
+	}
+
+	fmt.Printf("Path %s removed.\n", id)
+	return nil
+}
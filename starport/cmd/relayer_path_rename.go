@@ -0,0 +1,39 @@
+package starportcmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	relayerconf "github.com/tendermint/starport/starport/pkg/relayer/config"
+)
+
+// NewRelayerPathRename returns a new relayer path rename command.
+func NewRelayerPathRename() *cobra.Command {
+	return &cobra.Command{
+		Use:     "rename [path] [new-id]",
+		Short:   "Change the id of a path",
+		Aliases: []string{"mv"},
+		Args:    cobra.ExactArgs(2),
+		RunE:    relayerPathRenameHandler,
+	}
+}
+
+func relayerPathRenameHandler(cmd *cobra.Command, args []string) error {
+	id, newID := args[0], args[1]
+
+	conf, err := relayerconf.Get()
+	if err != nil {
+		return err
+	}
+
+	if err := conf.RenamePath(id, newID); err != nil {
+		return err
+	}
+
+	if err := relayerconf.Save(conf); err != nil {
+		return err
+	}
+
+	fmt.Printf("Path %s renamed to %s.\n", id, newID)
+	return nil
+}
@@ -0,0 +1,42 @@
+package starportcmd
+
+import (
+	"fmt"
+
+	"github.com/goccy/go-yaml"
+	"github.com/spf13/cobra"
+	relayerconf "github.com/tendermint/starport/starport/pkg/relayer/config"
+)
+
+// NewRelayerPathShow returns a new relayer path show command.
+func NewRelayerPathShow() *cobra.Command {
+	return &cobra.Command{
+		Use:   "show [path]",
+		Short: "Show a path with its chains",
+		Args:  cobra.ExactArgs(1),
+		RunE:  relayerPathShowHandler,
+	}
+}
+
+func relayerPathShowHandler(cmd *cobra.Command, args []string) error {
+	conf, err := relayerconf.Get()
+	if err != nil {
+		return err
+	}
+
+	exported, err := conf.Export(args[0])
+	if err != nil {
+		return err
+	}
+
+	out, err := yaml.Marshal(struct {
+		Chains []relayerconf.Chain `yaml:"chains"`
+		Path   relayerconf.Path    `yaml:"path"`
+	}{exported.Chains, exported.Paths[0]})
+	if err != nil {
+		return err
+	}
+
+	fmt.Print(string(out))
+	return nil
+}
@@ -0,0 +1,106 @@
+package starportcmd
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/tendermint/starport/starport/pkg/clispinner"
+	"github.com/tendermint/starport/starport/pkg/relayer"
+	relayerconf "github.com/tendermint/starport/starport/pkg/relayer/config"
+)
+
+// NewRelayerStatus returns a new relayer status command to show the status of all or some paths.
+func NewRelayerStatus() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "status [<path>,...]",
+		Short: "Show pending packets, relayed packets, client expiries and balances of paths",
+		RunE:  relayerStatusHandler,
+	}
+
+	c.Flags().AddFlagSet(flagSetKeyringBackend())
+
+	return c
+}
+
+func relayerStatusHandler(cmd *cobra.Command, args []string) (err error) {
+	defer func() {
+		err = handleRelayerAccountErr(err)
+	}()
+
+	ca, err := newAccountRegistry(cmd)
+	if err != nil {
+		return err
+	}
+
+	s := clispinner.New().SetText("Querying chains...")
+	defer s.Stop()
+
+	statuses, err := relayer.New(ca).Status(cmd.Context(), args...)
+	if err != nil {
+		return err
+	}
+
+	s.Stop()
+
+	if len(statuses) == 0 {
+		fmt.Println("No paths found.")
+		return nil
+	}
+
+	for _, status := range statuses {
+		printRelayerStatus(status)
+	}
+
+	return nil
+}
+
+func printRelayerStatus(status relayer.PathStatus) {
+	path := status.Path
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 1, ' ', tabwriter.TabIndent)
+	defer w.Flush()
+
+	fmt.Fprintf(w, "%s:\n", path.ID)
+	fmt.Fprintln(w, "   \tchain\tchannel\tpending packets\tpending acks\trelayed packets\trelayed acks\tclient expiry\tbalance")
+
+	for _, e := range []struct {
+		pathEnd relayerconf.PathEnd
+		status  relayer.EndStatus
+	}{
+		{path.Src, status.Src},
+		{path.Dst, status.Dst},
+	} {
+		channelID, expiry := e.pathEnd.ChannelID, "-"
+		if channelID == "" {
+			channelID = "-"
+		}
+		if !e.status.ClientExpiry.IsZero() {
+			expiry = e.status.ClientExpiry.Format(time.RFC3339)
+		}
+
+		balance := e.status.Balances.String()
+		if balance == "" {
+			balance = "-"
+		}
+
+		fmt.Fprintf(w, "   \t%s\t%s\t%d\t%d\t%d\t%d\t%s\t%s\n",
+			e.pathEnd.ChainID,
+			channelID,
+			e.status.PendingPackets,
+			e.status.PendingAcks,
+			e.pathEnd.PacketsRelayed,
+			e.pathEnd.AcksRelayed,
+			expiry,
+			balance,
+		)
+	}
+
+	if path.LastError != "" {
+		fmt.Fprintf(w, "   \tlast error (%s): %s\n", path.LastErrorAt.Format(time.RFC3339), path.LastError)
+	}
+
+	fmt.Fprintln(w)
+}
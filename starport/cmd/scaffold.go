@@ -16,6 +16,7 @@ const (
 	flagNoMessage   = "no-message"
 	flagResponse    = "response"
 	flagDescription = "desc"
+	flagEvents      = "events"
 )
 
 // NewScaffold returns a command that groups scaffolding related sub commands.
@@ -39,6 +40,10 @@ CRUD stands for "create, read, update, delete".`,
 	c.AddCommand(NewScaffoldMessage())
 	c.AddCommand(NewScaffoldQuery())
 	c.AddCommand(NewScaffoldPacket())
+	c.AddCommand(NewScaffoldUpgrade())
+	c.AddCommand(NewScaffoldInvariant())
+	c.AddCommand(NewScaffoldHook())
+	c.AddCommand(NewScaffoldParams())
 	c.AddCommand(NewScaffoldBandchain())
 	c.AddCommand(NewScaffoldVue())
 	c.AddCommand(NewScaffoldFlutter())
@@ -58,6 +63,7 @@ func scaffoldType(
 		moduleName     = flagGetModule(cmd)
 		withoutMessage = flagGetNoMessage(cmd)
 		signer         = flagGetSigner(cmd)
+		withEvents     = flagGetEvents(cmd)
 		appPath        = flagGetPath(cmd)
 	)
 
@@ -74,6 +80,9 @@ func scaffoldType(
 	} else if signer != "" {
 		options = append(options, scaffolder.TypeWithSigner(signer))
 	}
+	if withEvents {
+		options = append(options, scaffolder.TypeWithEvents())
+	}
 
 	s := clispinner.New().SetText("Scaffolding...")
 	defer s.Stop()
@@ -119,6 +128,15 @@ func flagGetNoMessage(cmd *cobra.Command) bool {
 	return noMessage
 }
 
+func flagSetEvents(cmd *cobra.Command) {
+	cmd.Flags().Bool(flagEvents, false, "Emit typed events from the message handlers")
+}
+
+func flagGetEvents(cmd *cobra.Command) bool {
+	events, _ := cmd.Flags().GetBool(flagEvents)
+	return events
+}
+
 func flagGetSigner(cmd *cobra.Command) string {
 	signer, _ := cmd.Flags().GetString(flagSigner)
 	return signer
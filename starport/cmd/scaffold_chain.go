@@ -11,6 +11,7 @@ import (
 
 const (
 	flagNoDefaultModule = "no-module"
+	flagAuthz           = "authz"
 )
 
 // NewScaffoldChain creates new command to scaffold a Comos-SDK based blockchain.
@@ -26,6 +27,7 @@ func NewScaffoldChain() *cobra.Command {
 	c.Flags().StringP(flagPath, "p", ".", "path to scaffold the chain")
 	c.Flags().String(flagAddressPrefix, "cosmos", "Address prefix")
 	c.Flags().Bool(flagNoDefaultModule, false, "Prevent scaffolding a default module in the app")
+	c.Flags().Bool(flagAuthz, false, "Register the authz module to execute messages on behalf of other accounts")
 
 	return c
 }
@@ -38,10 +40,11 @@ func scaffoldChainHandler(cmd *cobra.Command, args []string) error {
 		name               = args[0]
 		addressPrefix, _   = cmd.Flags().GetString(flagAddressPrefix)
 		noDefaultModule, _ = cmd.Flags().GetBool(flagNoDefaultModule)
+		authz, _           = cmd.Flags().GetBool(flagAuthz)
 		appPath            = flagGetPath(cmd)
 	)
 
-	appdir, err := scaffolder.Init(placeholder.New(), appPath, name, addressPrefix, noDefaultModule)
+	appdir, err := scaffolder.Init(placeholder.New(), appPath, name, addressPrefix, noDefaultModule, authz)
 	if err != nil {
 		return err
 	}
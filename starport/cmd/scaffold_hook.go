@@ -0,0 +1,59 @@
+package starportcmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/tendermint/starport/starport/pkg/clispinner"
+	"github.com/tendermint/starport/starport/pkg/placeholder"
+	"github.com/tendermint/starport/starport/templates/hook"
+)
+
+// NewScaffoldHook returns the command to scaffold a BeginBlock or EndBlock hook
+func NewScaffoldHook() *cobra.Command {
+	c := &cobra.Command{
+		Use:       "hook [begin|end]",
+		Short:     "Hook executed at the beginning or the end of every block",
+		Args:      cobra.ExactValidArgs(1),
+		ValidArgs: []string{string(hook.BeginBlock), string(hook.EndBlock)},
+		RunE:      hookHandler,
+	}
+
+	flagSetPath(c)
+	c.Flags().String(flagModule, "", "Module to add the hook into. Default: app's main module")
+
+	return c
+}
+
+func hookHandler(cmd *cobra.Command, args []string) error {
+	var (
+		kind       = hook.Kind(args[0])
+		moduleName = flagGetModule(cmd)
+		appPath    = flagGetPath(cmd)
+	)
+
+	s := clispinner.New().SetText("Scaffolding...")
+	defer s.Stop()
+
+	sc, err := newApp(appPath)
+	if err != nil {
+		return err
+	}
+
+	sm, err := sc.AddHook(placeholder.New(), moduleName, kind)
+	if err != nil {
+		return err
+	}
+
+	s.Stop()
+
+	modificationsStr, err := sourceModificationToString(sm)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(modificationsStr)
+	fmt.Printf("\n🎉 Created the %[1]v block hook.\n\n", kind)
+
+	return nil
+}
@@ -0,0 +1,57 @@
+package starportcmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/tendermint/starport/starport/pkg/clispinner"
+	"github.com/tendermint/starport/starport/pkg/placeholder"
+)
+
+// NewScaffoldInvariant returns the command to scaffold a module invariant
+func NewScaffoldInvariant() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "invariant [name]",
+		Short: "Invariant checking the state of a module",
+		Args:  cobra.ExactArgs(1),
+		RunE:  invariantHandler,
+	}
+
+	flagSetPath(c)
+	c.Flags().String(flagModule, "", "Module to add the invariant into. Default: app's main module")
+
+	return c
+}
+
+func invariantHandler(cmd *cobra.Command, args []string) error {
+	var (
+		invariantName = args[0]
+		moduleName    = flagGetModule(cmd)
+		appPath       = flagGetPath(cmd)
+	)
+
+	s := clispinner.New().SetText("Scaffolding...")
+	defer s.Stop()
+
+	sc, err := newApp(appPath)
+	if err != nil {
+		return err
+	}
+
+	sm, err := sc.AddInvariant(placeholder.New(), moduleName, invariantName)
+	if err != nil {
+		return err
+	}
+
+	s.Stop()
+
+	modificationsStr, err := sourceModificationToString(sm)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(modificationsStr)
+	fmt.Printf("\n🎉 Created the invariant `%[1]v`.\n\n", invariantName)
+
+	return nil
+}
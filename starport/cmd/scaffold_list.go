@@ -16,6 +16,7 @@ func NewScaffoldList() *cobra.Command {
 
 	flagSetPath(c)
 	c.Flags().AddFlagSet(flagSetScaffoldType())
+	flagSetEvents(c)
 
 	return c
 }
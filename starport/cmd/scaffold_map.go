@@ -20,6 +20,7 @@ func NewScaffoldMap() *cobra.Command {
 
 	flagSetPath(c)
 	c.Flags().AddFlagSet(flagSetScaffoldType())
+	flagSetEvents(c)
 	c.Flags().StringSlice(FlagIndexes, []string{"index"}, "fields that index the value")
 
 	return c
@@ -9,7 +9,10 @@ import (
 	"github.com/tendermint/starport/starport/services/scaffolder"
 )
 
-const flagSigner = "signer"
+const (
+	flagSigner        = "signer"
+	flagAuthorization = "authorization"
+)
 
 // NewScaffoldMessage returns the command to scaffold messages
 func NewScaffoldMessage() *cobra.Command {
@@ -25,6 +28,9 @@ func NewScaffoldMessage() *cobra.Command {
 	c.Flags().StringSliceP(flagResponse, "r", []string{}, "Response fields")
 	c.Flags().StringP(flagDescription, "d", "", "Description of the command")
 	c.Flags().String(flagSigner, "", "Label for the message signer (default: creator)")
+	flagSetEvents(c)
+	c.Flags().Bool(flagAuthz, false, "Make the message executable with authz on behalf of a granter")
+	c.Flags().Bool(flagAuthorization, false, "Scaffold a typed authz authorization for the message")
 
 	return c
 }
@@ -53,6 +59,21 @@ func messageHandler(cmd *cobra.Command, args []string) error {
 		options = append(options, scaffolder.WithSigner(signer))
 	}
 
+	// Emit events
+	if flagGetEvents(cmd) {
+		options = append(options, scaffolder.WithEvents())
+	}
+
+	// Execute with authz
+	authz, _ := cmd.Flags().GetBool(flagAuthz)
+	if authz {
+		options = append(options, scaffolder.WithAuthz())
+	}
+	authorization, _ := cmd.Flags().GetBool(flagAuthorization)
+	if authorization {
+		options = append(options, scaffolder.WithAuthorization())
+	}
+
 	sc, err := newApp(appPath)
 	if err != nil {
 		return err
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"strings"
+	"unicode"
 
 	"github.com/spf13/cobra"
 	"github.com/tendermint/starport/starport/pkg/clispinner"
@@ -28,17 +29,23 @@ func NewScaffoldModule() *cobra.Command {
 	c := &cobra.Command{
 		Use:   "module [name]",
 		Short: "Scaffold a Cosmos SDK module",
-		Long:  "Scaffold a new Cosmos SDK module in the `x` directory",
-		Args:  cobra.MinimumNArgs(1),
-		RunE:  scaffoldModuleHandler,
+		Long: `Scaffold a new Cosmos SDK module in the "x" directory.
+
+Dependencies given with --dep are added to the expected keepers of the module, the keeper
+methods to import are given after a colon, e.g. "--dep bank:SendCoins,GetBalance". The keeper
+name defaults to the title-cased module name followed by "Keeper", a different one is given
+with a dot, e.g. "--dep staking.StakingKeeper:GetValidator". The former "--dep bank:BankKeeper"
+format, where the value after the colon ends with "Keeper", still sets the keeper name.`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: scaffoldModuleHandler,
 	}
 
 	flagSetPath(c)
-	c.Flags().StringSlice(flagDep, []string{}, "module dependencies (e.g. --dep account,bank)")
+	c.Flags().StringSlice(flagDep, []string{}, "module dependencies with the keeper methods to import, <depName>[.<depKeeperName>][:<Method>,...] (e.g. --dep account,bank:SendCoins,GetBalance,staking.StakingKeeper:GetValidator)")
 	c.Flags().Bool(flagIBC, false, "scaffold an IBC module")
 	c.Flags().String(flagIBCOrdering, "none", "channel ordering of the IBC module [none|ordered|unordered]")
 	c.Flags().Bool(flagRequireRegistration, false, "if true command will fail if module can't be registered")
-	c.Flags().StringSlice(flagParams, []string{}, "scaffold module params")
+	c.Flags().StringSlice(flagParams, []string{}, "scaffold module params with optional validation rules (e.g. --params maxFoo:uint:min=1:max=100)")
 
 	return c
 }
@@ -80,29 +87,16 @@ func scaffoldModuleHandler(cmd *cobra.Command, args []string) error {
 	}
 
 	// Get module dependencies
-	dependencies, err := cmd.Flags().GetStringSlice(flagDep)
+	depFlags, err := cmd.Flags().GetStringSlice(flagDep)
+	if err != nil {
+		return err
+	}
+	dependencies, err := parseDependencies(depFlags)
 	if err != nil {
 		return err
 	}
 	if len(dependencies) > 0 {
-		var formattedDependencies []modulecreate.Dependency
-
-		// Parse the provided dependencies
-		for _, dependency := range dependencies {
-			var formattedDependency modulecreate.Dependency
-
-			splitted := strings.Split(dependency, ":")
-			switch len(splitted) {
-			case 1:
-				formattedDependency = modulecreate.NewDependency(splitted[0], "")
-			case 2:
-				formattedDependency = modulecreate.NewDependency(splitted[0], splitted[1])
-			default:
-				return fmt.Errorf("dependency %s is invalid, must have <depName> or <depName>.<depKeeperName>", dependency)
-			}
-			formattedDependencies = append(formattedDependencies, formattedDependency)
-		}
-		options = append(options, scaffolder.WithDependencies(formattedDependencies))
+		options = append(options, scaffolder.WithDependencies(dependencies))
 	}
 
 	var msg bytes.Buffer
@@ -113,7 +107,7 @@ func scaffoldModuleHandler(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	sm, err := sc.CreateModule(placeholder.New(), name, options...)
+	sm, err := sc.CreateModule(cmd.Context(), placeholder.New(), name, options...)
 	s.Stop()
 	if err != nil {
 		var validationErr validation.Error
@@ -140,6 +134,58 @@ func scaffoldModuleHandler(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// parseDependencies parses the dependencies provided with the format <depName>[.<depKeeperName>][:<Method>]
+// the values following a dependency that start with an uppercase letter are additional methods of this dependency.
+// the former <depName>:<depKeeperName> format is still supported, the value after the colon is used as the
+// keeper name when it ends with "Keeper"
+func parseDependencies(dependencies []string) ([]modulecreate.Dependency, error) {
+	var formattedDependencies []modulecreate.Dependency
+	for _, dependency := range dependencies {
+		// method imported from the previous dependency
+		if dependency != "" && unicode.IsUpper(rune(dependency[0])) {
+			last := len(formattedDependencies) - 1
+			if last < 0 || len(formattedDependencies[last].Methods) == 0 {
+				return nil, fmt.Errorf("method %s must follow a dependency with the format <depName>:<Method>", dependency)
+			}
+			formattedDependencies[last].Methods = append(formattedDependencies[last].Methods, dependency)
+			continue
+		}
+
+		name, method := dependency, ""
+		if i := strings.Index(dependency, ":"); i >= 0 {
+			name, method = dependency[:i], dependency[i+1:]
+			if method == "" {
+				return nil, fmt.Errorf("dependency %s is invalid, the method is missing", dependency)
+			}
+		}
+
+		// former format with the keeper name after the colon
+		if strings.HasSuffix(method, "Keeper") {
+			if strings.Contains(name, ".") {
+				return nil, fmt.Errorf("dependency %s is invalid, the keeper name is given twice", dependency)
+			}
+			formattedDependencies = append(formattedDependencies, modulecreate.NewDependency(name, method))
+			continue
+		}
+
+		var formattedDependency modulecreate.Dependency
+		splitted := strings.Split(name, ".")
+		switch len(splitted) {
+		case 1:
+			formattedDependency = modulecreate.NewDependency(splitted[0], "")
+		case 2:
+			formattedDependency = modulecreate.NewDependency(splitted[0], splitted[1])
+		default:
+			return nil, fmt.Errorf("dependency %s is invalid, must have <depName> or <depName>.<depKeeperName>", dependency)
+		}
+		if method != "" {
+			formattedDependency.Methods = []string{method}
+		}
+		formattedDependencies = append(formattedDependencies, formattedDependency)
+	}
+	return formattedDependencies, nil
+}
+
 // in previously scaffolded apps gov keeper is defined below the scaffolded module keeper definition
 // therefore we must warn the user to manually move the definition if it's the case
 // https://github.com/tendermint/starport/issues/818#issuecomment-865736052
@@ -152,9 +198,9 @@ app.GovKeeper = ...
 `
 
 // dependencyWarning is used to print a warning if gov is provided as a dependency
-func dependencyWarning(dependencies []string) {
+func dependencyWarning(dependencies []modulecreate.Dependency) {
 	for _, dep := range dependencies {
-		if dep == "gov" {
+		if dep.Name == "gov" {
 			fmt.Print(govWarning)
 		}
 	}
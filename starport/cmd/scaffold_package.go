@@ -11,7 +11,8 @@ import (
 )
 
 const (
-	flagAck = "ack"
+	flagAck     = "ack"
+	flagTimeout = "timeout"
 )
 
 // NewScaffoldPacket creates a new packet in the module
@@ -19,9 +20,16 @@ func NewScaffoldPacket() *cobra.Command {
 	c := &cobra.Command{
 		Use:   "packet [packetName] [field1] [field2] ... --module [moduleName]",
 		Short: "Message for sending an IBC packet",
-		Long:  "Scaffold an IBC packet in a specific IBC-enabled Cosmos SDK module",
-		Args:  cobra.MinimumNArgs(1),
-		RunE:  createPacketHandler,
+		Long: `Scaffold an IBC packet in a specific IBC-enabled Cosmos SDK module
+
+The send message of the packet accepts relative or absolute timeouts with the
+--packet-timeout-height, --packet-timeout-timestamp and --absolute-timeouts flags.
+Its default relative timeout timestamp is 10 minutes, it can be customized with --timeout.
+
+When the module depends on the bank module, the coins of the packet are escrowed
+when the packet is sent and refunded to the sender on timeout or error acknowledgment.`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: createPacketHandler,
 	}
 
 	flagSetPath(c)
@@ -29,6 +37,7 @@ func NewScaffoldPacket() *cobra.Command {
 	c.Flags().String(flagModule, "", "IBC Module to add the packet into")
 	c.Flags().String(flagSigner, "", "Label for the message signer (default: creator)")
 	c.Flags().Bool(flagNoMessage, false, "Disable send message scaffolding")
+	c.Flags().Duration(flagTimeout, 0, "Default relative timeout of the packets sent with the send message, 0 uses the default of the module (10 minutes)")
 
 	return c
 }
@@ -62,11 +71,21 @@ func createPacketHandler(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	timeout, err := cmd.Flags().GetDuration(flagTimeout)
+	if err != nil {
+		return err
+	}
+
 	var options []scaffolder.PacketOption
 	if noMessage {
 		options = append(options, scaffolder.PacketWithoutMessage())
-	} else if signer != "" {
-		options = append(options, scaffolder.PacketWithSigner(signer))
+	} else {
+		if signer != "" {
+			options = append(options, scaffolder.PacketWithSigner(signer))
+		}
+		if timeout != 0 {
+			options = append(options, scaffolder.PacketWithTimeout(timeout))
+		}
 	}
 
 	sc, err := newApp(appPath)
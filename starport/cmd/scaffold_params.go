@@ -0,0 +1,64 @@
+package starportcmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/tendermint/starport/starport/pkg/clispinner"
+	"github.com/tendermint/starport/starport/pkg/placeholder"
+)
+
+// NewScaffoldParams returns the command to scaffold params in a module
+func NewScaffoldParams() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "params [param]...",
+		Short: "Params of an existing module",
+		Long: `Params of an existing module with optional validation rules.
+
+Each param is provided with the format name:type:rule1:rule2=value, the supported rules are:
+min=N and max=N for numbers, regex=EXPR for strings, nonempty for strings, lists and coins
+and default=VALUE to set the default value of the param.
+
+For example: starport scaffold params maxFoo:uint:min=1:max=100 denom:string:regex=^[a-z]+$ --module foo`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: paramsHandler,
+	}
+
+	flagSetPath(c)
+	c.Flags().String(flagModule, "", "Module to add the params into. Default: app's main module")
+
+	return c
+}
+
+func paramsHandler(cmd *cobra.Command, args []string) error {
+	var (
+		moduleName = flagGetModule(cmd)
+		appPath    = flagGetPath(cmd)
+	)
+
+	s := clispinner.New().SetText("Scaffolding...")
+	defer s.Stop()
+
+	sc, err := newApp(appPath)
+	if err != nil {
+		return err
+	}
+
+	sm, err := sc.AddParams(placeholder.New(), moduleName, args)
+	if err != nil {
+		return err
+	}
+
+	s.Stop()
+
+	modificationsStr, err := sourceModificationToString(sm)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(modificationsStr)
+	fmt.Printf("\n🎉 Created the params %s.\n\n", strings.Join(args, ", "))
+
+	return nil
+}
@@ -6,10 +6,13 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/tendermint/starport/starport/pkg/clispinner"
 	"github.com/tendermint/starport/starport/pkg/placeholder"
+	"github.com/tendermint/starport/starport/services/scaffolder"
 )
 
 const (
-	flagPaginated = "paginated"
+	flagPaginated  = "paginated"
+	flagRestRoute  = "rest-route"
+	flagHTTPMethod = "http-method"
 )
 
 // NewScaffoldQuery command creates a new type command to scaffold queries
@@ -17,8 +20,16 @@ func NewScaffoldQuery() *cobra.Command {
 	c := &cobra.Command{
 		Use:   "query [name] [request_field1] [request_field2] ...",
 		Short: "Query to get data from the blockchain",
-		Args:  cobra.MinimumNArgs(1),
-		RunE:  queryHandler,
+		Long: `Query to get data from the blockchain.
+
+The response fields can return the types scaffolded in the module: a single value (post:Post),
+an array (posts:array.Post) or a map indexed by string keys (posts:map.Post).
+When the type is stored in the module as a list, a map or a singleton, the keeper fetches the values from the store.
+
+The REST route of the query can be customized with a route template referencing the request fields,
+e.g. --rest-route "/posts/{author}", relative routes are prefixed with the route of the module.`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: queryHandler,
 	}
 
 	flagSetPath(c)
@@ -26,6 +37,8 @@ func NewScaffoldQuery() *cobra.Command {
 	c.Flags().StringSliceP(flagResponse, "r", []string{}, "Response fields")
 	c.Flags().StringP(flagDescription, "d", "", "Description of the command")
 	c.Flags().Bool(flagPaginated, false, "Define if the request can be paginated")
+	c.Flags().String(flagRestRoute, "", "Route template of the REST endpoint, e.g. /posts/{id}")
+	c.Flags().String(flagHTTPMethod, "get", "HTTP method of the REST endpoint [get|post]")
 
 	return c
 }
@@ -63,12 +76,33 @@ func queryHandler(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	restRoute, err := cmd.Flags().GetString(flagRestRoute)
+	if err != nil {
+		return err
+	}
+
+	httpMethod, err := cmd.Flags().GetString(flagHTTPMethod)
+	if err != nil {
+		return err
+	}
+
 	sc, err := newApp(appPath)
 	if err != nil {
 		return err
 	}
 
-	sm, err := sc.AddQuery(cmd.Context(), placeholder.New(), module, args[0], desc, args[1:], resFields, paginated)
+	sm, err := sc.AddQuery(
+		cmd.Context(),
+		placeholder.New(),
+		module,
+		args[0],
+		desc,
+		args[1:],
+		resFields,
+		paginated,
+		scaffolder.QueryWithRestRoute(restRoute),
+		scaffolder.QueryWithHTTPMethod(httpMethod),
+	)
 	if err != nil {
 		return err
 	}
@@ -16,6 +16,7 @@ func NewScaffoldSingle() *cobra.Command {
 
 	flagSetPath(c)
 	c.Flags().AddFlagSet(flagSetScaffoldType())
+	flagSetEvents(c)
 
 	return c
 }
@@ -0,0 +1,59 @@
+package starportcmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/tendermint/starport/starport/pkg/clispinner"
+	"github.com/tendermint/starport/starport/pkg/placeholder"
+)
+
+// NewScaffoldUpgrade returns the command to scaffold a chain upgrade
+func NewScaffoldUpgrade() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "upgrade [name]",
+		Short: "Upgrade handler and store migration for a module",
+		Long: `Register an upgrade handler in the app, bump the consensus version of the module
+and scaffold the store migration to run when the upgrade is applied.`,
+		Args: cobra.ExactArgs(1),
+		RunE: upgradeHandler,
+	}
+
+	flagSetPath(c)
+	c.Flags().String(flagModule, "", "Module to migrate. Default: app's main module")
+
+	return c
+}
+
+func upgradeHandler(cmd *cobra.Command, args []string) error {
+	var (
+		upgradeName = args[0]
+		moduleName  = flagGetModule(cmd)
+		appPath     = flagGetPath(cmd)
+	)
+
+	s := clispinner.New().SetText("Scaffolding...")
+	defer s.Stop()
+
+	sc, err := newApp(appPath)
+	if err != nil {
+		return err
+	}
+
+	sm, err := sc.AddUpgrade(placeholder.New(), moduleName, upgradeName)
+	if err != nil {
+		return err
+	}
+
+	s.Stop()
+
+	modificationsStr, err := sourceModificationToString(sm)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(modificationsStr)
+	fmt.Printf("\n🎉 Created the upgrade `%[1]v`.\n\n", upgradeName)
+
+	return nil
+}
@@ -2,6 +2,7 @@ package chaincmd
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/tendermint/starport/starport/pkg/cmdrunner/step"
 	"github.com/tendermint/starport/starport/pkg/cosmosver"
@@ -39,6 +40,8 @@ const (
 	optionYes                              = "--yes"
 	optionHomeClient                       = "--home-client"
 	optionCoinType                         = "--coin-type"
+	optionVestingAmount                    = "--vesting-amount"
+	optionVestingEndTime                   = "--vesting-end-time"
 
 	constTendermint = "tendermint"
 	constJSON       = "json"
@@ -262,6 +265,22 @@ func (c ChainCmd) AddGenesisAccountCommand(address string, coins string) step.Op
 	return c.daemonCommand(command)
 }
 
+// AddVestingAccountCommand returns the command to add a new delayed vesting account in the genesis file
+// of the chain. coins are the total balance of the account and vestingCoins are locked until vestingEndTime.
+func (c ChainCmd) AddVestingAccountCommand(address, coins, vestingCoins string, vestingEndTime int64) step.Option {
+	command := []string{
+		commandAddGenesisAccount,
+		address,
+		coins,
+		optionVestingAmount,
+		vestingCoins,
+		optionVestingEndTime,
+		strconv.FormatInt(vestingEndTime, 10),
+	}
+
+	return c.daemonCommand(command)
+}
+
 // GentxOption for the GentxCommand
 type GentxOption func([]string) []string
 
@@ -3,15 +3,21 @@ package cosmosaccount
 import (
 	"errors"
 	"fmt"
+	"io"
 	"os"
 
 	dkeyring "github.com/99designs/keyring"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/bech32"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/go-bip39"
+	"github.com/tendermint/starport/starport/pkg/randstr"
 )
 
 const (
@@ -44,6 +50,10 @@ const (
 	// KeyringOS is the OS keyring backend. with this backend, your keys will be
 	// stored in your operating system's secured keyring.
 	KeyringOS KeyringBackend = "os"
+
+	// KeyringFile is the encrypted file keyring backend. With this backend, your keys will be
+	// stored under your app's data dir encrypted with a passphrase.
+	KeyringFile KeyringBackend = "file"
 )
 
 // Registry for accounts.
@@ -51,6 +61,7 @@ type Registry struct {
 	homePath           string
 	keyringServiceName string
 	keyringBackend     KeyringBackend
+	keyringPassphrase  string
 
 	Keyring keyring.Keyring
 }
@@ -76,6 +87,14 @@ func WithKeyringBackend(backend KeyringBackend) Option {
 	}
 }
 
+// WithKeyringPassphrase sets the passphrase used to unlock the file keyring backend.
+// when it is not provided, passphrase is prompted from the stdin.
+func WithKeyringPassphrase(passphrase string) Option {
+	return func(c *Registry) {
+		c.keyringPassphrase = passphrase
+	}
+}
+
 // New creates a new registry to manage accounts.
 func New(options ...Option) (Registry, error) {
 	r := Registry{
@@ -88,9 +107,16 @@ func New(options ...Option) (Registry, error) {
 		apply(&r)
 	}
 
-	var err error
+	var (
+		err   error
+		input io.Reader = os.Stdin
+	)
 
-	r.Keyring, err = keyring.New(r.keyringServiceName, string(r.keyringBackend), r.homePath, os.Stdin)
+	if r.keyringPassphrase != "" {
+		input = newPassphraseReader(r.keyringPassphrase)
+	}
+
+	r.Keyring, err = keyring.New(r.keyringServiceName, string(r.keyringBackend), r.homePath, input)
 	if err != nil {
 		return Registry{}, err
 	}
@@ -114,6 +140,10 @@ type Account struct {
 
 	// Info holds additional info about the account.
 	Info keyring.Info
+
+	// HDPath is the HD path the account is derived from.
+	// it is empty when the account is not created from a mnemonic by the registry.
+	HDPath string
 }
 
 // Address returns the address of the account from given prefix.
@@ -130,6 +160,12 @@ func (a Account) PubKey() string {
 	return a.Info.GetPubKey().String()
 }
 
+// IsRemote returns true when the registry only knows about the public key of the account
+// and signing is delegated to a remote signer.
+func (a Account) IsRemote() bool {
+	return a.Info.GetType() == keyring.TypeOffline
+}
+
 func toBench32(prefix string, addr []byte) string {
 	bech32Addr, err := bech32.ConvertAndEncode(prefix, addr)
 	if err != nil {
@@ -152,7 +188,8 @@ func (r Registry) EnsureDefaultAccount() error {
 }
 
 // Create creates a new account with name.
-func (r Registry) Create(name string) (acc Account, mnemonic string, err error) {
+// options can be used to customize the HD path of the account derived from the new mnemonic.
+func (r Registry) Create(name string, options ...AccountOption) (acc Account, mnemonic string, err error) {
 	acc, err = r.GetByName(name)
 	if err == nil {
 		return Account{}, "", ErrAccountExists
@@ -171,26 +208,34 @@ func (r Registry) Create(name string) (acc Account, mnemonic string, err error)
 		return Account{}, "", err
 	}
 
+	path, err := hdPath(options)
+	if err != nil {
+		return Account{}, "", err
+	}
 	algo, err := r.algo()
 	if err != nil {
 		return Account{}, "", err
 	}
-	info, err := r.Keyring.NewAccount(name, mnemonic, "", r.hdPath(), algo)
+	info, err := r.Keyring.NewAccount(name, mnemonic, "", path, algo)
 	if err != nil {
 		return Account{}, "", err
 	}
+	if err := r.saveHDPath(name, path); err != nil {
+		return Account{}, "", err
+	}
 
 	acc = Account{
-		Name: name,
-		Info: info,
+		Name:   name,
+		Info:   info,
+		HDPath: path,
 	}
 
 	return acc, mnemonic, nil
 }
 
 // Import imports an existing account with name and passphrase and secret where secret can be a
-// mnemonic or a private key.
-func (r Registry) Import(name, secret, passphrase string) (Account, error) {
+// mnemonic or a private key. options are used to customize the HD path when secret is a mnemonic.
+func (r Registry) Import(name, secret, passphrase string, options ...AccountOption) (Account, error) {
 	_, err := r.GetByName(name)
 	if err == nil {
 		return Account{}, ErrAccountExists
@@ -201,14 +246,21 @@ func (r Registry) Import(name, secret, passphrase string) (Account, error) {
 	}
 
 	if bip39.IsMnemonicValid(secret) {
+		path, err := hdPath(options)
+		if err != nil {
+			return Account{}, err
+		}
 		algo, err := r.algo()
 		if err != nil {
 			return Account{}, err
 		}
-		_, err = r.Keyring.NewAccount(name, secret, passphrase, r.hdPath(), algo)
+		_, err = r.Keyring.NewAccount(name, secret, passphrase, path, algo)
 		if err != nil {
 			return Account{}, err
 		}
+		if err := r.saveHDPath(name, path); err != nil {
+			return Account{}, err
+		}
 	} else if err := r.Keyring.ImportPrivKey(name, secret, passphrase); err != nil {
 		return Account{}, err
 	}
@@ -216,6 +268,45 @@ func (r Registry) Import(name, secret, passphrase string) (Account, error) {
 	return r.GetByName(name)
 }
 
+// RegisterRemote registers a remote account with name by only using its public key.
+// private key of the account is kept by a remote signer.
+func (r Registry) RegisterRemote(name string, pubKey cryptotypes.PubKey) (Account, error) {
+	_, err := r.GetByName(name)
+	if err == nil {
+		return Account{}, ErrAccountExists
+	}
+	var accErr *AccountDoesNotExistError
+	if !errors.As(err, &accErr) {
+		return Account{}, err
+	}
+
+	info, err := r.Keyring.SavePubKey(name, pubKey, hd.Secp256k1Type)
+	if err != nil {
+		return Account{}, err
+	}
+
+	acc := Account{
+		Name: name,
+		Info: info,
+	}
+
+	return acc, nil
+}
+
+// PubKeyFromJSON decodes a public key from its JSON form,
+// e.g. {"@type":"/cosmos.crypto.secp256k1.PubKey","key":"..."}.
+func PubKeyFromJSON(pubKey string) (cryptotypes.PubKey, error) {
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(interfaceRegistry)
+
+	var pk cryptotypes.PubKey
+	if err := codec.NewProtoCodec(interfaceRegistry).UnmarshalInterfaceJSON([]byte(pubKey), &pk); err != nil {
+		return nil, err
+	}
+
+	return pk, nil
+}
+
 // Export exports an account as a private key.
 func (r Registry) Export(name, passphrase string) (key string, err error) {
 	if _, err = r.GetByName(name); err != nil {
@@ -235,6 +326,47 @@ func (r Registry) ExportHex(name, passphrase string) (hex string, err error) {
 	return keyring.NewUnsafe(r.Keyring).UnsafeExportPrivKeyHex(name)
 }
 
+// CopyTo copies the account with name into dst registry, the account keeps its HD path.
+// copying is skipped when dst already has the same key under name.
+func (r Registry) CopyTo(dst Registry, name string) (Account, error) {
+	acc, err := r.GetByName(name)
+	if err != nil {
+		return Account{}, err
+	}
+
+	existing, err := dst.GetByName(name)
+	if err == nil {
+		if !existing.Info.GetPubKey().Equals(acc.Info.GetPubKey()) {
+			return Account{}, fmt.Errorf("%w with a different key: %s", ErrAccountExists, name)
+		}
+		return existing, nil
+	}
+	var accErr *AccountDoesNotExistError
+	if !errors.As(err, &accErr) {
+		return Account{}, err
+	}
+
+	if acc.IsRemote() {
+		return dst.RegisterRemote(name, acc.Info.GetPubKey())
+	}
+
+	// the armor is only kept in memory, its passphrase is used just once.
+	passphrase := randstr.Runes(32)
+
+	armor, err := r.Keyring.ExportPrivKeyArmor(name, passphrase)
+	if err != nil {
+		return Account{}, err
+	}
+	if err := dst.Keyring.ImportPrivKey(name, armor, passphrase); err != nil {
+		return Account{}, err
+	}
+	if err := dst.saveHDPath(name, acc.HDPath); err != nil {
+		return Account{}, err
+	}
+
+	return dst.GetByName(name)
+}
+
 // GetByName returns an account by its name.
 func (r Registry) GetByName(name string) (Account, error) {
 	info, err := r.Keyring.Key(name)
@@ -242,12 +374,18 @@ func (r Registry) GetByName(name string) (Account, error) {
 		return Account{}, &AccountDoesNotExistError{name}
 	}
 	if err != nil {
-		return Account{}, nil
+		return Account{}, err
+	}
+
+	paths, err := r.loadHDPaths()
+	if err != nil {
+		return Account{}, err
 	}
 
 	acc := Account{
-		Name: name,
-		Info: info,
+		Name:   name,
+		Info:   info,
+		HDPath: paths[name],
 	}
 
 	return acc, nil
@@ -260,12 +398,18 @@ func (r Registry) List() ([]Account, error) {
 		return nil, err
 	}
 
+	paths, err := r.loadHDPaths()
+	if err != nil {
+		return nil, err
+	}
+
 	var accounts []Account
 
 	for _, accinfo := range info {
 		accounts = append(accounts, Account{
-			Name: accinfo.GetName(),
-			Info: accinfo,
+			Name:   accinfo.GetName(),
+			Info:   accinfo,
+			HDPath: paths[accinfo.GetName()],
 		})
 	}
 
@@ -278,11 +422,10 @@ func (r Registry) DeleteByName(name string) error {
 	if err == dkeyring.ErrKeyNotFound {
 		return &AccountDoesNotExistError{name}
 	}
-	return err
-}
-
-func (r Registry) hdPath() string {
-	return hd.CreateHDPath(sdktypes.GetConfig().GetCoinType(), 0, 0).String()
+	if err != nil {
+		return err
+	}
+	return r.saveHDPath(name, "")
 }
 
 func (r Registry) algo() (keyring.SignatureAlgo, error) {
@@ -297,3 +440,22 @@ type AccountDoesNotExistError struct {
 func (e *AccountDoesNotExistError) Error() string {
 	return fmt.Sprintf("account %q does not exist", e.Name)
 }
+
+// passphraseReader answers every passphrase prompt of the keyring with the same passphrase.
+type passphraseReader struct {
+	line   []byte
+	offset int
+}
+
+func newPassphraseReader(passphrase string) *passphraseReader {
+	return &passphraseReader{line: []byte(passphrase + "\n")}
+}
+
+func (r *passphraseReader) Read(p []byte) (n int, err error) {
+	for n < len(p) {
+		copied := copy(p[n:], r.line[r.offset:])
+		n += copied
+		r.offset = (r.offset + copied) % len(r.line)
+	}
+	return n, nil
+}
@@ -0,0 +1,116 @@
+package cosmosaccount_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/starport/starport/pkg/cosmosaccount"
+)
+
+const testAccountName = "alice"
+
+func TestFileKeyringPassphrase(t *testing.T) {
+	home := t.TempDir()
+
+	registry, err := cosmosaccount.New(
+		cosmosaccount.WithHome(home),
+		cosmosaccount.WithKeyringBackend(cosmosaccount.KeyringFile),
+		cosmosaccount.WithKeyringPassphrase("passphrase"),
+	)
+	require.NoError(t, err)
+
+	account, _, err := registry.Create(testAccountName)
+	require.NoError(t, err)
+
+	// reopen the keyring to make sure that the passphrase is asked again.
+	registry, err = cosmosaccount.New(
+		cosmosaccount.WithHome(home),
+		cosmosaccount.WithKeyringBackend(cosmosaccount.KeyringFile),
+		cosmosaccount.WithKeyringPassphrase("passphrase"),
+	)
+	require.NoError(t, err)
+
+	got, err := registry.GetByName(testAccountName)
+	require.NoError(t, err)
+	require.Equal(t, account.Address(""), got.Address(""))
+
+	registry, err = cosmosaccount.New(
+		cosmosaccount.WithHome(home),
+		cosmosaccount.WithKeyringBackend(cosmosaccount.KeyringFile),
+		cosmosaccount.WithKeyringPassphrase("wrong passphrase"),
+	)
+	require.NoError(t, err)
+
+	_, err = registry.GetByName(testAccountName)
+	require.Error(t, err)
+}
+
+func TestDerive(t *testing.T) {
+	registry, err := cosmosaccount.New(cosmosaccount.WithHome(t.TempDir()))
+	require.NoError(t, err)
+
+	account, mnemonic, err := registry.Create(testAccountName)
+	require.NoError(t, err)
+	require.Equal(t, "m/44'/118'/0'/0/0", account.HDPath)
+
+	derived, err := registry.Derive("bob", testAccountName, mnemonic, "", cosmosaccount.WithAddressIndex(1))
+	require.NoError(t, err)
+	require.Equal(t, "m/44'/118'/0'/0/1", derived.HDPath)
+	require.NotEqual(t, account.Address(""), derived.Address(""))
+
+	_, mnemonic, err = registry.Create("carol")
+	require.NoError(t, err)
+
+	_, err = registry.Derive("dave", testAccountName, mnemonic, "", cosmosaccount.WithAddressIndex(1))
+	require.Error(t, err)
+
+	accounts, err := registry.List()
+	require.NoError(t, err)
+	require.Len(t, accounts, 3)
+
+	require.NoError(t, registry.DeleteByName("bob"))
+
+	_, err = registry.Import("bob", mnemonic, "", cosmosaccount.WithHDPath("m/44'/529'/0'/0/2"))
+	require.NoError(t, err)
+
+	imported, err := registry.GetByName("bob")
+	require.NoError(t, err)
+	require.Equal(t, "m/44'/529'/0'/0/2", imported.HDPath)
+}
+
+func TestCopyTo(t *testing.T) {
+	src, err := cosmosaccount.New(cosmosaccount.WithHome(t.TempDir()))
+	require.NoError(t, err)
+
+	dstHome := t.TempDir()
+	dst, err := cosmosaccount.New(
+		cosmosaccount.WithHome(dstHome),
+		cosmosaccount.WithKeyringBackend(cosmosaccount.KeyringFile),
+		cosmosaccount.WithKeyringPassphrase("passphrase"),
+	)
+	require.NoError(t, err)
+
+	account, _, err := src.Create(testAccountName, cosmosaccount.WithAddressIndex(3))
+	require.NoError(t, err)
+
+	copied, err := src.CopyTo(dst, testAccountName)
+	require.NoError(t, err)
+	require.Equal(t, account.Address(""), copied.Address(""))
+	require.Equal(t, account.HDPath, copied.HDPath)
+
+	// the HD paths are kept in the keyring directory, not in the root of the home.
+	require.NoFileExists(t, filepath.Join(dstHome, "hdpaths.yml"))
+	require.FileExists(t, filepath.Join(dstHome, "keyring-file", "hdpaths.yml"))
+
+	// copying the same key again is a no-op.
+	_, err = src.CopyTo(dst, testAccountName)
+	require.NoError(t, err)
+
+	require.NoError(t, src.DeleteByName(testAccountName))
+	_, _, err = src.Create(testAccountName)
+	require.NoError(t, err)
+
+	_, err = src.CopyTo(dst, testAccountName)
+	require.ErrorIs(t, err, cosmosaccount.ErrAccountExists)
+}
@@ -0,0 +1,140 @@
+package cosmosaccount
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/starport/starport/pkg/confile"
+)
+
+// hdPathsFile keeps the HD paths of the accounts derived from a mnemonic.
+const hdPathsFile = "hdpaths.yml"
+
+// derivationOptions holds the options for deriving an account from a mnemonic.
+type derivationOptions struct {
+	accountNumber uint32
+	addressIndex  uint32
+	hdPath        string
+}
+
+// AccountOption configures how an account is derived from a mnemonic.
+type AccountOption func(*derivationOptions)
+
+// WithAccountNumber sets the account number of the BIP44 path.
+func WithAccountNumber(number uint32) AccountOption {
+	return func(o *derivationOptions) {
+		o.accountNumber = number
+	}
+}
+
+// WithAddressIndex sets the address index of the BIP44 path.
+func WithAddressIndex(index uint32) AccountOption {
+	return func(o *derivationOptions) {
+		o.addressIndex = index
+	}
+}
+
+// WithHDPath sets a full HD path, e.g. m/44'/118'/0'/0/0, to derive the account from.
+// it takes precedence over the account number and the address index.
+func WithHDPath(path string) AccountOption {
+	return func(o *derivationOptions) {
+		o.hdPath = path
+	}
+}
+
+// hdPath returns the HD path configured by options.
+func hdPath(options []AccountOption) (string, error) {
+	var o derivationOptions
+	for _, apply := range options {
+		apply(&o)
+	}
+
+	if o.hdPath == "" {
+		return hd.CreateHDPath(sdktypes.GetConfig().GetCoinType(), o.accountNumber, o.addressIndex).String(), nil
+	}
+
+	params, err := hd.NewParamsFromPath(o.hdPath)
+	if err != nil {
+		return "", fmt.Errorf("invalid hd path %q: %w", o.hdPath, err)
+	}
+
+	return params.String(), nil
+}
+
+// Derive derives a new account with name from mnemonic by using the given options.
+// mnemonicFrom is the name of an existing account, it is used to make sure that
+// mnemonic is the one that account was created from.
+func (r Registry) Derive(name, mnemonicFrom, mnemonic, passphrase string, options ...AccountOption) (Account, error) {
+	from, err := r.GetByName(mnemonicFrom)
+	if err != nil {
+		return Account{}, err
+	}
+
+	fromPath := from.HDPath
+	if fromPath == "" {
+		if fromPath, err = hdPath(nil); err != nil {
+			return Account{}, err
+		}
+	}
+
+	privKey, err := hd.Secp256k1.Derive()(mnemonic, passphrase, fromPath)
+	if err != nil {
+		return Account{}, err
+	}
+
+	addr := hd.Secp256k1.Generate()(privKey).PubKey().Address()
+	if !bytes.Equal(addr, from.Info.GetPubKey().Address()) {
+		return Account{}, fmt.Errorf("mnemonic does not belong to account %q", mnemonicFrom)
+	}
+
+	return r.Import(name, mnemonic, passphrase, options...)
+}
+
+// hdPathsPath returns the path of the file keeping the HD paths of the accounts.
+// the file is kept with the keys in the keyring directory of the backend. the os backend
+// doesn't store its keys in a directory, so its HD paths are kept in Starport's accounts
+// directory under the keyring service name.
+func (r Registry) hdPathsPath() string {
+	switch r.keyringBackend {
+	case KeyringTest, KeyringFile:
+		return filepath.Join(r.homePath, "keyring-"+string(r.keyringBackend), hdPathsFile)
+	default:
+		return filepath.Join(KeyringHome, "hdpaths", r.keyringServiceName+".yml")
+	}
+}
+
+func (r Registry) hdPathsConfig() *confile.ConfigFile {
+	return confile.New(confile.DefaultYAMLEncodingCreator, r.hdPathsPath())
+}
+
+// loadHDPaths returns the known HD paths by account names.
+func (r Registry) loadHDPaths() (map[string]string, error) {
+	paths := make(map[string]string)
+	if err := r.hdPathsConfig().Load(&paths); err != nil {
+		return nil, err
+	}
+	return paths, nil
+}
+
+// saveHDPath saves the HD path of an account, an empty path removes it.
+func (r Registry) saveHDPath(name, path string) error {
+	paths, err := r.loadHDPaths()
+	if err != nil {
+		return err
+	}
+
+	if _, ok := paths[name]; !ok && path == "" {
+		return nil
+	}
+
+	if path == "" {
+		delete(paths, name)
+	} else {
+		paths[name] = path
+	}
+
+	return r.hdPathsConfig().Save(paths)
+}
@@ -6,6 +6,8 @@ import (
 	"go/ast"
 	"go/parser"
 	"go/token"
+	"path"
+	"strconv"
 
 	"github.com/tendermint/starport/starport/pkg/cosmosanalysis"
 )
@@ -16,24 +18,84 @@ var appImplementation = []string{
 	"RegisterTendermintService",
 }
 
+// KeeperType describes the type of a keeper declared in the app structure
+type KeeperType struct {
+	// PkgPath is the import path of the package declaring the keeper type
+	PkgPath string
+
+	// Name is the name of the keeper type
+	Name string
+
+	// Pointer is true if the app holds a pointer to the keeper
+	Pointer bool
+}
+
 // CheckKeeper checks for the existence of the keeper with the provided name in the app structure
 func CheckKeeper(path, keeperName string) error {
+	_, _, err := findKeeper(path, keeperName)
+	return err
+}
+
+// FindKeeperType returns the type of the keeper with the provided name in the app structure
+func FindKeeperType(appPath, keeperName string) (KeeperType, error) {
+	field, file, err := findKeeper(appPath, keeperName)
+	if err != nil {
+		return KeeperType{}, err
+	}
+
+	var keeperType KeeperType
+	expr := field.Type
+	if star, ok := expr.(*ast.StarExpr); ok {
+		keeperType.Pointer = true
+		expr = star.X
+	}
+	sel, ok := expr.(*ast.SelectorExpr)
+	if !ok {
+		return KeeperType{}, fmt.Errorf("%s must be declared with a type from another package", keeperName)
+	}
+	pkgName, ok := sel.X.(*ast.Ident)
+	if !ok {
+		return KeeperType{}, fmt.Errorf("%s must be declared with a type from another package", keeperName)
+	}
+	keeperType.Name = sel.Sel.Name
+
+	// resolve the package of the keeper type from the imports of the app
+	for _, imp := range file.Imports {
+		importPath, err := strconv.Unquote(imp.Path.Value)
+		if err != nil {
+			return KeeperType{}, err
+		}
+		name := path.Base(importPath)
+		if imp.Name != nil {
+			name = imp.Name.Name
+		}
+		if name == pkgName.Name {
+			keeperType.PkgPath = importPath
+			return keeperType, nil
+		}
+	}
+
+	return KeeperType{}, fmt.Errorf("the package of %s can't be found in the app imports", keeperName)
+}
+
+// findKeeper finds the field declaring the keeper with the provided name in the app structure
+// and the file containing it
+func findKeeper(path, keeperName string) (keeperField *ast.Field, keeperFile *ast.File, err error) {
 	// find app type
 	appImpl, err := cosmosanalysis.FindImplementation(path, appImplementation)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 	if len(appImpl) != 1 {
-		return errors.New("app.go should contain a single app")
+		return nil, nil, errors.New("app.go should contain a single app")
 	}
 	appTypeName := appImpl[0]
 
 	// Inspect the module for app struct
-	var found bool
 	fileSet := token.NewFileSet()
 	pkgs, err := parser.ParseDir(fileSet, path, nil, 0)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 	for _, pkg := range pkgs {
 		for _, f := range pkg.Files {
@@ -53,7 +115,7 @@ func CheckKeeper(path, keeperName string) error {
 				for _, field := range appStruct.Fields.List {
 					for _, fieldName := range field.Names {
 						if fieldName.Name == keeperName {
-							found = true
+							keeperField, keeperFile = field, f
 							return false
 						}
 					}
@@ -64,8 +126,8 @@ func CheckKeeper(path, keeperName string) error {
 		}
 	}
 
-	if !found {
-		return fmt.Errorf("app doesn't contain %s", keeperName)
+	if keeperField == nil {
+		return nil, nil, fmt.Errorf("app doesn't contain %s", keeperName)
 	}
-	return nil
+	return keeperField, keeperFile, nil
 }
@@ -86,3 +86,46 @@ func TestCheckKeeper(t *testing.T) {
 	err = app.CheckKeeper(tmpDirTwoApp, "FooKeeper")
 	require.Error(t, err)
 }
+
+func TestFindKeeperType(t *testing.T) {
+	tmpDir := t.TempDir()
+	err := os.WriteFile(filepath.Join(tmpDir, "app.go"), []byte(`
+package foo
+
+import (
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	"github.com/cosmos/ibc-go/modules/core/keeper"
+)
+
+type Foo struct {
+	BankKeeper bankkeeper.Keeper
+	IBCKeeper  *keeper.Keeper
+	Other      int
+}
+
+func (f Foo) RegisterAPIRoutes() {}
+func (f Foo) RegisterTxService() {}
+func (f Foo) RegisterTendermintService() {}
+`), 0644)
+	require.NoError(t, err)
+
+	keeperType, err := app.FindKeeperType(tmpDir, "BankKeeper")
+	require.NoError(t, err)
+	require.Equal(t, app.KeeperType{
+		PkgPath: "github.com/cosmos/cosmos-sdk/x/bank/keeper",
+		Name:    "Keeper",
+	}, keeperType)
+
+	keeperType, err = app.FindKeeperType(tmpDir, "IBCKeeper")
+	require.NoError(t, err)
+	require.Equal(t, app.KeeperType{
+		PkgPath: "github.com/cosmos/ibc-go/modules/core/keeper",
+		Name:    "Keeper",
+		Pointer: true,
+	}, keeperType)
+
+	_, err = app.FindKeeperType(tmpDir, "Other")
+	require.Error(t, err)
+	_, err = app.FindKeeperType(tmpDir, "BarKeeper")
+	require.Error(t, err)
+}
@@ -9,6 +9,7 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
 	"sync"
@@ -20,14 +21,18 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	staking "github.com/cosmos/cosmos-sdk/x/staking/types"
+	ibctypes "github.com/cosmos/ibc-go/modules/core/types"
 	"github.com/pkg/errors"
 	"github.com/tendermint/starport/starport/pkg/cosmosaccount"
 	"github.com/tendermint/starport/starport/pkg/cosmosfaucet"
 	rpchttp "github.com/tendermint/tendermint/rpc/client/http"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
 )
 
 const (
@@ -70,6 +75,11 @@ type Client struct {
 	homePath           string
 	keyringServiceName string
 	keyringBackend     cosmosaccount.KeyringBackend
+	keyringPassphrase  string
+
+	gasPrices string
+
+	signer Signer
 }
 
 // Option configures your client.
@@ -99,6 +109,38 @@ func WithKeyringBackend(backend cosmosaccount.KeyringBackend) Option {
 	}
 }
 
+// WithKeyringPassphrase sets the passphrase used to unlock your keyring when you are using
+// the file keyring backend. when it is not provided, passphrase is prompted from the stdin.
+func WithKeyringPassphrase(passphrase string) Option {
+	return func(c *Client) {
+		c.keyringPassphrase = passphrase
+	}
+}
+
+// WithSigner sets the signer used to sign the transactions of remote accounts, the ones that
+// only have a public key in the keyring. Transactions of the other accounts are always signed
+// with the keys in the keyring.
+func WithSigner(signer Signer) Option {
+	return func(c *Client) {
+		c.signer = signer
+	}
+}
+
+// WithAccountRegistry sets the registry used to access accounts. When this option is provided
+// keyring options are ignored.
+func WithAccountRegistry(registry cosmosaccount.Registry) Option {
+	return func(c *Client) {
+		c.AccountRegistry = registry
+	}
+}
+
+// WithGasPrices sets the gas prices, e.g. `0.025stake`, paid for transactions.
+func WithGasPrices(gasPrices string) Option {
+	return func(c *Client) {
+		c.gasPrices = gasPrices
+	}
+}
+
 // WithNodeAddress sets the node address of your chain. When this option is not provided
 // `http://localhost:26657` is used as default.
 func WithNodeAddress(addr string) Option {
@@ -165,16 +207,25 @@ func New(ctx context.Context, options ...Option) (Client, error) {
 		c.homePath = filepath.Join(home, "."+c.chainID)
 	}
 
-	c.AccountRegistry, err = cosmosaccount.New(
-		cosmosaccount.WithKeyringServiceName(c.keyringServiceName),
-		cosmosaccount.WithKeyringBackend(c.keyringBackend),
-	)
-	if err != nil {
-		return Client{}, err
+	if c.AccountRegistry.Keyring == nil {
+		registryOptions := []cosmosaccount.Option{
+			cosmosaccount.WithKeyringPassphrase(c.keyringPassphrase),
+		}
+		if c.keyringBackend != "" {
+			registryOptions = append(registryOptions, cosmosaccount.WithKeyringBackend(c.keyringBackend))
+		}
+		if c.keyringServiceName != "" {
+			registryOptions = append(registryOptions, cosmosaccount.WithKeyringServiceName(c.keyringServiceName))
+		}
+
+		c.AccountRegistry, err = cosmosaccount.New(registryOptions...)
+		if err != nil {
+			return Client{}, err
+		}
 	}
 
 	c.Context = newContext(c.RPC, c.out, c.chainID, c.homePath).WithKeyring(c.AccountRegistry.Keyring)
-	c.Factory = newFactory(c.Context)
+	c.Factory = newFactory(c.Context).WithGasPrices(c.gasPrices)
 
 	return c, nil
 }
@@ -192,9 +243,108 @@ func (c Client) Address(accountName string) (sdktypes.AccAddress, error) {
 	return account.Info.GetAddress(), nil
 }
 
+// BankBalances returns the balances of address.
+func (c Client) BankBalances(ctx context.Context, address string) (sdktypes.Coins, error) {
+	var (
+		queryClient = banktypes.NewQueryClient(c.Context)
+		balances    sdktypes.Coins
+		key         []byte
+	)
+
+	// balances are paginated, fetch them until the last page.
+	for {
+		res, err := queryClient.AllBalances(ctx, &banktypes.QueryAllBalancesRequest{
+			Address:    address,
+			Pagination: &query.PageRequest{Key: key},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		balances = append(balances, res.Balances...)
+
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return balances, nil
+		}
+		key = res.Pagination.NextKey
+	}
+}
+
+// BankSendTx creates and broadcasts a tx to send coins from account to the toAddress.
+func (c Client) BankSendTx(ctx context.Context, accountName, toAddress string, coins sdktypes.Coins) (*sdktypes.TxResponse, error) {
+	account, err := c.Account(accountName)
+	if err != nil {
+		return nil, err
+	}
+
+	// addresses are given in bech32 instead of sdktypes.AccAddress to not depend on
+	// the global address prefix.
+	msg := &banktypes.MsgSend{
+		FromAddress: account.Address(c.addressPrefix),
+		ToAddress:   toAddress,
+		Amount:      coins,
+	}
+
+	return c.BroadcastTx(ctx, accountName, msg)
+}
+
+// TxsByAddress returns the latest transactions that are sent or received by address with the newest first.
+// limit is the maximum number of transactions returned.
+func (c Client) TxsByAddress(ctx context.Context, address string, limit int) ([]*ctypes.ResultTx, error) {
+	var (
+		page    = 1
+		queries = []string{
+			fmt.Sprintf("message.sender='%s'", address),
+			fmt.Sprintf("transfer.recipient='%s'", address),
+		}
+		seen = make(map[string]bool)
+		txs  []*ctypes.ResultTx
+	)
+
+	for _, query := range queries {
+		res, err := c.RPC.TxSearch(ctx, query, false, &page, &limit, "desc")
+		if err != nil {
+			return nil, err
+		}
+
+		for _, tx := range res.Txs {
+			if seen[tx.Hash.String()] {
+				continue
+			}
+			seen[tx.Hash.String()] = true
+			txs = append(txs, tx)
+		}
+	}
+
+	sort.SliceStable(txs, func(i, j int) bool {
+		if txs[i].Height == txs[j].Height {
+			return txs[i].Index > txs[j].Index
+		}
+		return txs[i].Height > txs[j].Height
+	})
+
+	// each direction returns up to limit txs.
+	if len(txs) > limit {
+		txs = txs[:limit]
+	}
+
+	return txs, nil
+}
+
+// signerOf returns the signer of the transactions of account.
+func (c Client) signerOf(account cosmosaccount.Account) (Signer, error) {
+	if !account.IsRemote() {
+		return KeyringSigner{Keyring: c.AccountRegistry.Keyring}, nil
+	}
+	if c.signer == nil {
+		return nil, fmt.Errorf("account %q is remote but no remote signer is set", account.Name)
+	}
+	return c.signer, nil
+}
+
 // BroadcastTx creates and broadcasts a tx with given messages for account.
-func (c Client) BroadcastTx(accountName string, msgs ...sdktypes.Msg) (*sdktypes.TxResponse, error) {
-	_, broadcast, err := c.BroadcastTxWithProvision(accountName, msgs...)
+func (c Client) BroadcastTx(ctx context.Context, accountName string, msgs ...sdktypes.Msg) (*sdktypes.TxResponse, error) {
+	_, broadcast, err := c.BroadcastTxWithProvision(ctx, accountName, msgs...)
 	if err != nil {
 		return nil, err
 	}
@@ -204,9 +354,9 @@ func (c Client) BroadcastTx(accountName string, msgs ...sdktypes.Msg) (*sdktypes
 // protects sdktypes.Config.
 var mconf sync.Mutex
 
-func (c Client) BroadcastTxWithProvision(accountName string, msgs ...sdktypes.Msg) (
+func (c Client) BroadcastTxWithProvision(ctx context.Context, accountName string, msgs ...sdktypes.Msg) (
 	gas uint64, broadcast func() (*sdktypes.TxResponse, error), err error) {
-	if err := c.prepareBroadcast(context.Background(), accountName, msgs); err != nil {
+	if err := c.prepareBroadcast(ctx, accountName, msgs); err != nil {
 		return 0, nil, err
 	}
 
@@ -216,14 +366,19 @@ func (c Client) BroadcastTxWithProvision(accountName string, msgs ...sdktypes.Ms
 	config := sdktypes.GetConfig()
 	config.SetBech32PrefixForAccount(c.addressPrefix, c.addressPrefix+"pub")
 
-	accountAddress, err := c.Address(accountName)
+	account, err := c.Account(accountName)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	signer, err := c.signerOf(account)
 	if err != nil {
 		return 0, nil, err
 	}
 
 	context := c.Context.
 		WithFromName(accountName).
-		WithFromAddress(accountAddress)
+		WithFromAddress(account.Info.GetAddress())
 
 	txf, err := c.Factory.Prepare(context)
 	if err != nil {
@@ -245,7 +400,7 @@ func (c Client) BroadcastTxWithProvision(accountName string, msgs ...sdktypes.Ms
 		if err != nil {
 			return nil, err
 		}
-		if err = tx.Sign(txf, accountName, txUnsigned, true); err != nil {
+		if err = signTx(ctx, signer, context.TxConfig, txf, account, txUnsigned); err != nil {
 			return nil, err
 		}
 
@@ -372,7 +527,8 @@ func newContext(
 	cryptocodec.RegisterInterfaces(interfaceRegistry)
 	sdktypes.RegisterInterfaces(interfaceRegistry)
 	staking.RegisterInterfaces(interfaceRegistry)
-	cryptocodec.RegisterInterfaces(interfaceRegistry)
+	banktypes.RegisterInterfaces(interfaceRegistry)
+	ibctypes.RegisterInterfaces(interfaceRegistry)
 
 	return client.Context{}.
 		WithChainID(chainID).
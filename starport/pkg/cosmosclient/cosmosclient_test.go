@@ -0,0 +1,43 @@
+package cosmosclient
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/starport/starport/pkg/cosmosaccount"
+)
+
+func TestSignerOf(t *testing.T) {
+	service, err := cosmosaccount.New(cosmosaccount.WithHome(t.TempDir()))
+	require.NoError(t, err)
+	remoteAccount, _, err := service.Create("alice")
+	require.NoError(t, err)
+
+	registry, err := cosmosaccount.New(cosmosaccount.WithHome(t.TempDir()))
+	require.NoError(t, err)
+	remote, err := registry.RegisterRemote("alice", remoteAccount.Info.GetPubKey())
+	require.NoError(t, err)
+	local, _, err := registry.Create("bob")
+	require.NoError(t, err)
+
+	// remote accounts need a remote signer.
+	c := Client{AccountRegistry: registry}
+
+	signer, err := c.signerOf(local)
+	require.NoError(t, err)
+	require.Equal(t, KeyringSigner{Keyring: registry.Keyring}, signer)
+
+	_, err = c.signerOf(remote)
+	require.Error(t, err)
+
+	// only remote accounts are signed with the remote signer.
+	c.signer = NewRemoteSigner("http://localhost:1")
+
+	signer, err = c.signerOf(local)
+	require.NoError(t, err)
+	require.Equal(t, KeyringSigner{Keyring: registry.Keyring}, signer)
+
+	signer, err = c.signerOf(remote)
+	require.NoError(t, err)
+	require.Equal(t, c.signer, signer)
+}
@@ -0,0 +1,81 @@
+package cosmosclient
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/tendermint/starport/starport/pkg/cosmosaccount"
+)
+
+// Signer signs transactions on behalf of accounts.
+type Signer interface {
+	// Sign signs the sign bytes of a transaction with the private key of account.
+	Sign(ctx context.Context, account cosmosaccount.Account, signBytes []byte) (signature []byte, err error)
+}
+
+// KeyringSigner signs transactions with the keys in a keyring.
+type KeyringSigner struct {
+	Keyring keyring.Keyring
+}
+
+// Sign implements Signer.
+func (s KeyringSigner) Sign(_ context.Context, account cosmosaccount.Account, signBytes []byte) ([]byte, error) {
+	signature, _, err := s.Keyring.Sign(account.Name, signBytes)
+	return signature, err
+}
+
+// signTx signs txBuilder for account with signer by overwriting the existing signatures.
+func signTx(
+	ctx context.Context,
+	signer Signer,
+	txConfig client.TxConfig,
+	txf tx.Factory,
+	account cosmosaccount.Account,
+	txBuilder client.TxBuilder,
+) error {
+	signMode := txf.SignMode()
+	if signMode == signing.SignMode_SIGN_MODE_UNSPECIFIED {
+		signMode = txConfig.SignModeHandler().DefaultMode()
+	}
+
+	var (
+		pubKey     = account.Info.GetPubKey()
+		signerData = authsigning.SignerData{
+			ChainID:       txf.ChainID(),
+			AccountNumber: txf.AccountNumber(),
+			Sequence:      txf.Sequence(),
+		}
+	)
+
+	// signer infos are needed to generate the sign bytes, so the signature is set
+	// without its bytes first.
+	sig := signing.SignatureV2{
+		PubKey:   pubKey,
+		Data:     &signing.SingleSignatureData{SignMode: signMode},
+		Sequence: txf.Sequence(),
+	}
+	if err := txBuilder.SetSignatures(sig); err != nil {
+		return err
+	}
+
+	signBytes, err := txConfig.SignModeHandler().GetSignBytes(signMode, signerData, txBuilder.GetTx())
+	if err != nil {
+		return err
+	}
+
+	signature, err := signer.Sign(ctx, account, signBytes)
+	if err != nil {
+		return err
+	}
+
+	sig.Data = &signing.SingleSignatureData{
+		SignMode:  signMode,
+		Signature: signature,
+	}
+
+	return txBuilder.SetSignatures(sig)
+}
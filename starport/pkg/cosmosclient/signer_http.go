@@ -0,0 +1,148 @@
+package cosmosclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/tendermint/starport/starport/pkg/cosmosaccount"
+	"github.com/tendermint/starport/starport/pkg/xhttp"
+)
+
+const (
+	// signPath is the endpoint of remote signing services.
+	signPath = "/sign"
+
+	// remoteSignTimeout is the timeout of a request to a remote signing service.
+	remoteSignTimeout = time.Second * 30
+)
+
+// SignRequest is the payload sent to a remote signing service.
+type SignRequest struct {
+	// Name of the account on the signing service.
+	Name string `json:"name"`
+
+	// PubKey is the public key of the account, it is used to make sure that
+	// both sides refer to the same key.
+	PubKey []byte `json:"pub_key"`
+
+	// SignBytes are the bytes to sign.
+	SignBytes []byte `json:"sign_bytes"`
+}
+
+// SignResponse is the payload returned by a remote signing service.
+type SignResponse struct {
+	Signature []byte `json:"signature"`
+}
+
+// RemoteSigner delegates signing to a remote signing service over HTTP.
+type RemoteSigner struct {
+	addr   string
+	client *http.Client
+}
+
+// NewRemoteSigner creates a signer for the signing service served at addr.
+func NewRemoteSigner(addr string) RemoteSigner {
+	return RemoteSigner{
+		addr:   strings.TrimSuffix(addr, "/"),
+		client: &http.Client{Timeout: remoteSignTimeout},
+	}
+}
+
+// Sign implements Signer.
+func (s RemoteSigner) Sign(ctx context.Context, account cosmosaccount.Account, signBytes []byte) ([]byte, error) {
+	data, err := json.Marshal(SignRequest{
+		Name:      account.Name,
+		PubKey:    account.Info.GetPubKey().Bytes(),
+		SignBytes: signBytes,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	hreq, err := http.NewRequestWithContext(ctx, http.MethodPost, s.addr+signPath, bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	hreq.Header.Set("Content-Type", "application/json")
+
+	hres, err := s.client.Do(hreq)
+	if err != nil {
+		return nil, err
+	}
+	defer hres.Body.Close()
+
+	if hres.StatusCode != http.StatusOK {
+		var res xhttp.ErrorResponseBody
+		if err := json.NewDecoder(hres.Body).Decode(&res); err != nil || res.Error.Message == "" {
+			return nil, errors.New(http.StatusText(hres.StatusCode))
+		}
+		return nil, fmt.Errorf("remote signer: %s", res.Error.Message)
+	}
+
+	var res SignResponse
+	if err := json.NewDecoder(hres.Body).Decode(&res); err != nil {
+		return nil, err
+	}
+
+	return res.Signature, nil
+}
+
+// SignerServer serves a signer as a remote signing service for the accounts in a registry.
+// it can be used as a reference implementation or as an in-process signing service in tests.
+type SignerServer struct {
+	signer   Signer
+	registry cosmosaccount.Registry
+}
+
+// NewSignerServer creates a signing service that signs for the accounts in registry with signer.
+func NewSignerServer(signer Signer, registry cosmosaccount.Registry) SignerServer {
+	return SignerServer{
+		signer:   signer,
+		registry: registry,
+	}
+}
+
+// ServeHTTP implements http.Handler.
+func (s SignerServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != signPath {
+		http.NotFound(w, r)
+		return
+	}
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req SignRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		xhttp.ResponseJSON(w, http.StatusBadRequest, xhttp.NewErrorResponse(err))
+		return
+	}
+
+	account, err := s.registry.GetByName(req.Name)
+	if err != nil {
+		xhttp.ResponseJSON(w, http.StatusNotFound, xhttp.NewErrorResponse(err))
+		return
+	}
+
+	if !bytes.Equal(account.Info.GetPubKey().Bytes(), req.PubKey) {
+		xhttp.ResponseJSON(w, http.StatusBadRequest, xhttp.NewErrorResponse(
+			fmt.Errorf("public key does not match with account %q", req.Name)),
+		)
+		return
+	}
+
+	signature, err := s.signer.Sign(r.Context(), account, req.SignBytes)
+	if err != nil {
+		xhttp.ResponseJSON(w, http.StatusInternalServerError, xhttp.NewErrorResponse(err))
+		return
+	}
+
+	xhttp.ResponseJSON(w, http.StatusOK, SignResponse{Signature: signature})
+}
@@ -0,0 +1,49 @@
+package cosmosclient_test
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/starport/starport/pkg/cosmosaccount"
+	"github.com/tendermint/starport/starport/pkg/cosmosclient"
+)
+
+func TestRemoteSigner(t *testing.T) {
+	// signing service keeps the private key.
+	service, err := cosmosaccount.New(cosmosaccount.WithHome(t.TempDir()))
+	require.NoError(t, err)
+
+	account, _, err := service.Create("alice")
+	require.NoError(t, err)
+
+	server := httptest.NewServer(cosmosclient.NewSignerServer(
+		cosmosclient.KeyringSigner{Keyring: service.Keyring},
+		service,
+	))
+	defer server.Close()
+
+	// client only knows about the public key.
+	registry, err := cosmosaccount.New(cosmosaccount.WithHome(t.TempDir()))
+	require.NoError(t, err)
+
+	remote, err := registry.RegisterRemote("alice", account.Info.GetPubKey())
+	require.NoError(t, err)
+	require.True(t, remote.IsRemote())
+	require.Equal(t, account.Address(""), remote.Address(""))
+
+	signBytes := []byte("sign bytes")
+
+	signature, err := cosmosclient.NewRemoteSigner(server.URL).Sign(context.Background(), remote, signBytes)
+	require.NoError(t, err)
+	require.True(t, remote.Info.GetPubKey().VerifySignature(signBytes, signature))
+
+	// signing service refuses to sign for a different key.
+	other, _, err := registry.Create("bob")
+	require.NoError(t, err)
+	other.Name = "alice"
+
+	_, err = cosmosclient.NewRemoteSigner(server.URL).Sign(context.Background(), other, signBytes)
+	require.Error(t, err)
+}
@@ -0,0 +1,76 @@
+package goanalysis
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"go/importer"
+	"go/token"
+	"go/types"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/tendermint/starport/starport/pkg/gocmd"
+)
+
+// TypeMethods returns the exported methods of the type typeName declared in the package pkgPath.
+// The package is resolved and compiled from the Go module located in path.
+// If pointer is true, the methods with a pointer receiver are also returned.
+func TypeMethods(ctx context.Context, path, pkgPath, typeName string, pointer bool) ([]*types.Func, error) {
+	exports, err := exportFiles(ctx, path, pkgPath)
+	if err != nil {
+		return nil, err
+	}
+
+	// load the package from the export data of the compiled packages
+	imp := importer.ForCompiler(token.NewFileSet(), "gc", func(importPath string) (io.ReadCloser, error) {
+		file, ok := exports[importPath]
+		if !ok {
+			return nil, fmt.Errorf("no export data for %s", importPath)
+		}
+		return os.Open(file)
+	})
+	pkg, err := imp.Import(pkgPath)
+	if err != nil {
+		return nil, err
+	}
+
+	obj, ok := pkg.Scope().Lookup(typeName).(*types.TypeName)
+	if !ok {
+		return nil, fmt.Errorf("type %s is not declared in %s", typeName, pkgPath)
+	}
+	t := obj.Type()
+	if pointer {
+		t = types.NewPointer(t)
+	}
+
+	var methods []*types.Func
+	methodSet := types.NewMethodSet(t)
+	for i := 0; i < methodSet.Len(); i++ {
+		method := methodSet.At(i).Obj().(*types.Func)
+		if method.Exported() {
+			methods = append(methods, method)
+		}
+	}
+	return methods, nil
+}
+
+// exportFiles returns the export data files of the package pkgPath and its dependencies indexed by import path.
+func exportFiles(ctx context.Context, path, pkgPath string) (map[string]string, error) {
+	out, err := gocmd.List(ctx, path, []string{"-export", "-deps", "-f", "{{.ImportPath}} {{.Export}}", pkgPath})
+	if err != nil {
+		return nil, err
+	}
+
+	exports := make(map[string]string)
+	scanner := bufio.NewScanner(bytes.NewReader(out))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 2 {
+			exports[fields[0]] = fields[1]
+		}
+	}
+	return exports, scanner.Err()
+}
@@ -0,0 +1,37 @@
+package goanalysis_test
+
+import (
+	"context"
+	"go/types"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/starport/starport/pkg/goanalysis"
+)
+
+func methodNames(methods []*types.Func) (names []string) {
+	for _, method := range methods {
+		names = append(names, method.Name())
+	}
+	return names
+}
+
+func TestTypeMethods(t *testing.T) {
+	ctx := context.Background()
+
+	methods, err := goanalysis.TypeMethods(ctx, ".", "time", "Duration", false)
+	require.NoError(t, err)
+	require.Contains(t, methodNames(methods), "Hours")
+
+	// strings.Builder only declares methods with a pointer receiver
+	methods, err = goanalysis.TypeMethods(ctx, ".", "strings", "Builder", false)
+	require.NoError(t, err)
+	require.Empty(t, methods)
+
+	methods, err = goanalysis.TypeMethods(ctx, ".", "strings", "Builder", true)
+	require.NoError(t, err)
+	require.Contains(t, methodNames(methods), "WriteString")
+
+	_, err = goanalysis.TypeMethods(ctx, ".", "strings", "Foo", false)
+	require.Error(t, err)
+}
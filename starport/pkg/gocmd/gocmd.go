@@ -1,6 +1,7 @@
 package gocmd
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -20,6 +21,9 @@ const (
 	// CommandBuild represents go "build" command.
 	CommandBuild = "build"
 
+	// CommandList represents go "list" command.
+	CommandList = "list"
+
 	// CommandMod represents go "mod" command.
 	CommandMod = "mod"
 
@@ -100,6 +104,21 @@ func InstallAll(ctx context.Context, path string, flags []string, options ...exe
 	return exec.Exec(ctx, command, append(options, exec.StepOption(step.Workdir(path)))...)
 }
 
+// List runs go list on path with flags and returns its output.
+func List(ctx context.Context, path string, flags []string, options ...exec.Option) ([]byte, error) {
+	var out bytes.Buffer
+	command := []string{
+		Name(),
+		CommandList,
+	}
+	command = append(command, flags...)
+	options = append(options, exec.StepOption(step.Workdir(path)), exec.StepOption(step.Stdout(&out)))
+	if err := exec.Exec(ctx, command, options...); err != nil {
+		return nil, err
+	}
+	return out.Bytes(), nil
+}
+
 // Ldflags returns a combined ldflags set from flags.
 func Ldflags(flags ...string) string {
 	return strings.Join(flags, " ")
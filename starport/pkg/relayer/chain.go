@@ -243,7 +243,10 @@ func (c *Chain) Connect(ctx context.Context, dst *Chain, options ...ChannelOptio
 
 // ensureChainSetup sets up the new or existing chain.
 func (c *Chain) ensureChainSetup(ctx context.Context) error {
-	client, err := cosmosclient.New(ctx, cosmosclient.WithNodeAddress(c.rpcAddress))
+	client, err := cosmosclient.New(ctx,
+		cosmosclient.WithNodeAddress(c.rpcAddress),
+		cosmosclient.WithAccountRegistry(c.r.ca),
+	)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,152 @@
+package relayer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	relayerconf "github.com/tendermint/starport/starport/pkg/relayer/config"
+	"github.com/tendermint/starport/starport/pkg/relayer/gorelayer"
+)
+
+const (
+	// expiredClientWarnInterval is the interval to report expired clients while relaying.
+	expiredClientWarnInterval = time.Hour
+
+	// clientCheckInterval is the interval to check clients that are not refreshed, e.g. when
+	// their trusting period is unknown.
+	clientCheckInterval = time.Hour
+)
+
+// ClientStatus is the status of a client used by a path.
+type ClientStatus struct {
+	gorelayer.ClientStatus
+
+	// PathID is the id of the path that uses the client.
+	PathID string
+}
+
+// Clients returns the status of the clients used by linked paths. clients of all linked paths
+// are returned when no path ids are given.
+func (r Relayer) Clients(ctx context.Context, pathIDs ...string) ([]ClientStatus, error) {
+	conf, err := relayerconf.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	paths, err := pathsByID(conf, pathIDs...)
+	if err != nil {
+		return nil, err
+	}
+
+	var statuses []ClientStatus
+
+	for _, path := range paths {
+		if path.Src.ChannelID == "" { // not linked yet.
+			continue
+		}
+
+		srcChain, dstChain, err := pathChains(conf, path)
+		if err != nil {
+			return nil, err
+		}
+
+		src, dst, err := r.goChains(ctx, srcChain, dstChain)
+		if err != nil {
+			return nil, err
+		}
+
+		clients, err := gorelayer.Clients(ctx, path, src, dst)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, client := range clients {
+			statuses = append(statuses, ClientStatus{ClientStatus: client, PathID: path.ID})
+		}
+	}
+
+	return statuses, nil
+}
+
+// ErrLinkGone is returned when a linked path needs to be linked again.
+var ErrLinkGone = gorelayer.ErrLinkGone
+
+// CheckLink checks that the linked path with id can still be relayed. it returns ErrLinkGone when
+// its clients are expired or its connections, clients or channels don't exist on the chains anymore,
+// e.g. when the state of the chains is reset. other errors are returned when the chains cannot be reached.
+func (r Relayer) CheckLink(ctx context.Context, pathID string) error {
+	conf, err := relayerconf.Get()
+	if err != nil {
+		return err
+	}
+
+	path, err := conf.PathByID(pathID)
+	if err != nil {
+		return err
+	}
+
+	if path.Src.ChannelID == "" {
+		return fmt.Errorf("%w: %s is not linked", ErrLinkGone, path.ID)
+	}
+
+	srcChain, dstChain, err := pathChains(conf, path)
+	if err != nil {
+		return err
+	}
+
+	src, dst, err := r.goChains(ctx, srcChain, dstChain)
+	if err != nil {
+		return err
+	}
+
+	return gorelayer.CheckLink(ctx, path, src, dst)
+}
+
+// refreshClients updates the clients of a linked path that are due to be refreshed, so they don't
+// expire while there are no packets to relay. it returns the time to refresh the clients next time.
+func (r Relayer) refreshClients(ctx context.Context, conf relayerconf.Config, path relayerconf.Path) (
+	next time.Time, err error) {
+	srcChain, dstChain, err := pathChains(conf, path)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	src, dst, err := r.goChains(ctx, srcChain, dstChain)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	clients, err := gorelayer.RefreshClients(ctx, path, src, dst)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	now := time.Now()
+
+	for _, client := range clients {
+		refreshAt := client.RefreshAt()
+
+		// clients with an unknown trusting period are not refreshed.
+		if refreshAt.IsZero() {
+			continue
+		}
+
+		if client.IsExpired(now) {
+			if r.onExpiredClient != nil {
+				r.onExpiredClient(ClientStatus{ClientStatus: client, PathID: path.ID})
+			}
+			refreshAt = now.Add(expiredClientWarnInterval)
+		}
+
+		if next.IsZero() || refreshAt.Before(next) {
+			next = refreshAt
+		}
+	}
+
+	if next.IsZero() {
+		next = now.Add(clientCheckInterval)
+	}
+
+	return next, nil
+}
@@ -4,20 +4,55 @@ import (
 	"fmt"
 	"os"
 	"reflect"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/tendermint/starport/starport/pkg/confile"
 )
 
-const supportVersion = "2"
+const supportVersion = "3"
 
 var configPath = os.ExpandEnv("$HOME/.starport/relayer/config.yml")
 
+const (
+	// BackendTSRelayer relays with the TypeScript relayer bundled in nodetime.
+	// it is the default backend.
+	BackendTSRelayer = "ts-relayer"
+
+	// BackendGo relays with the native Go relayer.
+	BackendGo = "go"
+)
+
 var ErrChainCannotBeFound = errors.New("chain cannot be found")
 var ErrPathCannotBeFound = errors.New("path cannot be found")
+var ErrChainAlreadyExists = errors.New("chain already exists")
+var ErrPathAlreadyExists = errors.New("path already exists")
+var ErrChainInUse = errors.New("chain is used by paths")
+var ErrNewerVersion = errors.New("config is created by a newer version of starport")
+var ErrOutdatedVersion = errors.New("config is outdated")
+
+// migrations upgrade configs from the version they are keyed with to the next version.
+var migrations = map[string]func(*Config){
+	// v2 configs are created before the Go relayer, so paths linked by them keep
+	// being relayed by the TypeScript relayer unless another backend is set.
+	"2": func(c *Config) {
+		if c.Backend != "" {
+			return
+		}
+		for _, path := range c.Paths {
+			if path.Src.ConnectionID != "" {
+				c.Backend = BackendTSRelayer
+				return
+			}
+		}
+	},
+}
 
 type Config struct {
 	Version string  `json:"version" yaml:"version"`
+	Backend string  `json:"backend" yaml:"backend,omitempty"`
 	Chains  []Chain `json:"chains" yaml:"chains,omitempty"`
 	Paths   []Path  `json:"paths" yaml:"paths,omitempty"`
 }
@@ -50,6 +85,182 @@ func (c Config) UpdatePath(path Path) error {
 	return errors.Wrap(ErrPathCannotBeFound, path.ID)
 }
 
+// AddPath adds a new path whose chains are already in the config.
+func (c *Config) AddPath(path Path) error {
+	if _, err := c.PathByID(path.ID); err == nil {
+		return errors.Wrap(ErrPathAlreadyExists, path.ID)
+	}
+	for _, id := range []string{path.Src.ChainID, path.Dst.ChainID} {
+		if _, err := c.ChainByID(id); err != nil {
+			return errors.Wrapf(err, "path %s", path.ID)
+		}
+	}
+	c.Paths = append(c.Paths, path)
+	return nil
+}
+
+// RemovePath removes the path with id.
+func (c *Config) RemovePath(id string) error {
+	for i, p := range c.Paths {
+		if p.ID == id {
+			c.Paths = append(c.Paths[:i], c.Paths[i+1:]...)
+			return nil
+		}
+	}
+	return errors.Wrap(ErrPathCannotBeFound, id)
+}
+
+// RenamePath changes the id of the path with id to newID.
+func (c Config) RenamePath(id, newID string) error {
+	if _, err := c.PathByID(newID); err == nil {
+		return errors.Wrap(ErrPathAlreadyExists, newID)
+	}
+	for i, p := range c.Paths {
+		if p.ID == id {
+			c.Paths[i].ID = newID
+			return nil
+		}
+	}
+	return errors.Wrap(ErrPathCannotBeFound, id)
+}
+
+// AddChain adds a new chain.
+func (c *Config) AddChain(chain Chain) error {
+	if _, err := c.ChainByID(chain.ID); err == nil {
+		return errors.Wrap(ErrChainAlreadyExists, chain.ID)
+	}
+	c.Chains = append(c.Chains, chain)
+	return nil
+}
+
+// UpdateChain replaces the chain that has the same id with chain.
+func (c Config) UpdateChain(chain Chain) error {
+	for i, ch := range c.Chains {
+		if ch.ID == chain.ID {
+			c.Chains[i] = chain
+			return nil
+		}
+	}
+	return errors.Wrap(ErrChainCannotBeFound, chain.ID)
+}
+
+// RemoveChain removes the chain with id. chains that are still used by paths cannot be removed.
+func (c *Config) RemoveChain(id string) error {
+	if paths := c.ChainPaths(id); len(paths) > 0 {
+		var ids []string
+		for _, path := range paths {
+			ids = append(ids, path.ID)
+		}
+		return errors.Wrapf(ErrChainInUse, "%s is used by %s", id, strings.Join(ids, ", "))
+	}
+	for i, ch := range c.Chains {
+		if ch.ID == id {
+			c.Chains = append(c.Chains[:i], c.Chains[i+1:]...)
+			return nil
+		}
+	}
+	return errors.Wrap(ErrChainCannotBeFound, id)
+}
+
+// ChainPaths returns the paths that have an end on the chain with id.
+func (c Config) ChainPaths(id string) []Path {
+	var paths []Path
+	for _, path := range c.Paths {
+		if path.Src.ChainID == id || path.Dst.ChainID == id {
+			paths = append(paths, path)
+		}
+	}
+	return paths
+}
+
+// Export returns a config that only has the paths with ids and the chains they use.
+func (c Config) Export(ids ...string) (Config, error) {
+	exported := Config{Version: supportVersion, Backend: c.Backend}
+	for _, id := range ids {
+		path, err := c.PathByID(id)
+		if err != nil {
+			return Config{}, err
+		}
+		for _, chainID := range []string{path.Src.ChainID, path.Dst.ChainID} {
+			if _, err := exported.ChainByID(chainID); err == nil {
+				continue
+			}
+			chain, err := c.ChainByID(chainID)
+			if err != nil {
+				return Config{}, errors.Wrapf(err, "path %s", id)
+			}
+			exported.Chains = append(exported.Chains, chain)
+		}
+		exported.Paths = append(exported.Paths, path)
+	}
+	return exported, nil
+}
+
+// Import adds the paths of imported with their chains. chains that already exist are kept as they are.
+// imported configs of older versions are migrated first, linked paths can only be imported when they
+// are relayed by the same backend.
+func (c *Config) Import(imported Config) error {
+	if err := migrate(&imported); err != nil {
+		return errors.Wrap(err, "imported config")
+	}
+	if err := imported.Validate(); err != nil {
+		return err
+	}
+	if backend(imported.Backend) != backend(c.Backend) {
+		for _, path := range imported.Paths {
+			if path.Src.ConnectionID != "" {
+				return fmt.Errorf("path %s is linked by the %s relayer backend but %s is used",
+					path.ID, backend(imported.Backend), backend(c.Backend))
+			}
+		}
+	}
+	for _, chain := range imported.Chains {
+		if _, err := c.ChainByID(chain.ID); err == nil {
+			continue
+		}
+		c.Chains = append(c.Chains, chain)
+	}
+	for _, path := range imported.Paths {
+		if err := c.AddPath(path); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate checks that ids are unique and the chains used by paths exist.
+func (c Config) Validate() error {
+	chains := make(map[string]bool)
+	for _, chain := range c.Chains {
+		if chain.ID == "" {
+			return errors.New("chain id cannot be empty")
+		}
+		if chains[chain.ID] {
+			return errors.Wrap(ErrChainAlreadyExists, chain.ID)
+		}
+		chains[chain.ID] = true
+	}
+
+	paths := make(map[string]bool)
+	for _, path := range c.Paths {
+		if path.ID == "" {
+			return errors.New("path id cannot be empty")
+		}
+		if paths[path.ID] {
+			return errors.Wrap(ErrPathAlreadyExists, path.ID)
+		}
+		paths[path.ID] = true
+
+		for _, id := range []string{path.Src.ChainID, path.Dst.ChainID} {
+			if !chains[id] {
+				return errors.Wrapf(ErrChainCannotBeFound, "%s used by path %s", id, path.ID)
+			}
+		}
+	}
+
+	return nil
+}
+
 type Chain struct {
 	ID            string `json:"id" yaml:"id"`
 	Account       string `json:"account" yaml:"account"`
@@ -60,10 +271,39 @@ type Chain struct {
 }
 
 type Path struct {
-	ID       string  `json:"id" yaml:"id"`
-	Ordering string  `json:"ordering" yaml:"ordering,omitempty"`
-	Src      PathEnd `json:"src" yaml:"src"`
-	Dst      PathEnd `json:"dst" yaml:"dst"`
+	ID          string    `json:"id" yaml:"id"`
+	Ordering    string    `json:"ordering" yaml:"ordering,omitempty"`
+	Src         PathEnd   `json:"src" yaml:"src"`
+	Dst         PathEnd   `json:"dst" yaml:"dst"`
+	Filter      Filter    `json:"filter" yaml:"filter,omitempty"`
+	LastError   string    `json:"last_error" yaml:"last_error,omitempty"`
+	LastErrorAt time.Time `json:"last_error_at" yaml:"last_error_at,omitempty"`
+}
+
+// Filter selects the packets relayed for a path, all packets are relayed when it is empty.
+// channels and ports match with the ones on either end of the path. sender and receiver
+// patterns are matched against the addresses in the packet data by using path.Match.
+type Filter struct {
+	AllowChannels []string `json:"allow_channels" yaml:"allow_channels,omitempty"`
+	DenyChannels  []string `json:"deny_channels" yaml:"deny_channels,omitempty"`
+	AllowPorts    []string `json:"allow_ports" yaml:"allow_ports,omitempty"`
+	DenyPorts     []string `json:"deny_ports" yaml:"deny_ports,omitempty"`
+	Senders       []string `json:"senders" yaml:"senders,omitempty"`
+	Receivers     []string `json:"receivers" yaml:"receivers,omitempty"`
+
+	// MinFee is the minimum fee, e.g. `100stake`, paid by the txs that send the packets.
+	MinFee string `json:"min_fee" yaml:"min_fee,omitempty"`
+}
+
+// IsEmpty checks if the filter doesn't filter any packets.
+func (f Filter) IsEmpty() bool {
+	return len(f.AllowChannels) == 0 &&
+		len(f.DenyChannels) == 0 &&
+		len(f.AllowPorts) == 0 &&
+		len(f.DenyPorts) == 0 &&
+		len(f.Senders) == 0 &&
+		len(f.Receivers) == 0 &&
+		f.MinFee == ""
 }
 
 type PathEnd struct {
@@ -74,17 +314,62 @@ type PathEnd struct {
 	Version      string `json:"version" yaml:"version,omitempty"`
 	PacketHeight int64  `json:"packet_height" yaml:"packet_height,omitempty"`
 	AckHeight    int64  `json:"ack_height" yaml:"ack_height,omitempty"`
+
+	// PacketsRelayed is the number of packets sent from this end that are relayed.
+	PacketsRelayed int64 `json:"packets_relayed" yaml:"packets_relayed,omitempty"`
+
+	// AcksRelayed is the number of acknowledgements relayed back to this end.
+	AcksRelayed int64 `json:"acks_relayed" yaml:"acks_relayed,omitempty"`
 }
 
+// Get loads the config, configs of older versions are migrated and saved.
 func Get() (Config, error) {
 	c := Config{}
 	if err := confile.New(confile.DefaultYAMLEncodingCreator, configPath).Load(&c); err != nil {
 		return c, err
 	}
-	if !reflect.DeepEqual(c, Config{}) && c.Version != supportVersion {
+	if reflect.DeepEqual(c, Config{}) || c.Version == supportVersion {
+		return c, nil
+	}
+	switch err := migrate(&c); {
+	case errors.Is(err, ErrNewerVersion):
+		return c, fmt.Errorf("your relayer setup at %s is created by a newer version of starport", configPath)
+	case errors.Is(err, ErrOutdatedVersion):
 		return c, fmt.Errorf("your relayer setup is outdated. run 'rm %s' and configure relayer again", configPath)
+	case err != nil:
+		return c, err
+	}
+	return c, Save(c)
+}
+
+// migrate upgrades c to the supported version.
+func migrate(c *Config) error {
+	supported, _ := strconv.Atoi(supportVersion)
+	if v, err := strconv.Atoi(c.Version); err == nil && v > supported {
+		return errors.Wrapf(ErrNewerVersion, "version %s", c.Version)
+	}
+	for c.Version != supportVersion {
+		m, ok := migrations[c.Version]
+		if !ok {
+			return errors.Wrapf(ErrOutdatedVersion, "version %q", c.Version)
+		}
+		m(c)
+
+		v, err := strconv.Atoi(c.Version)
+		if err != nil {
+			return err
+		}
+		c.Version = strconv.Itoa(v + 1)
+	}
+	return nil
+}
+
+// backend returns the relayer backend used for the configured backend name.
+func backend(name string) string {
+	if name == "" {
+		return BackendTSRelayer
 	}
-	return c, nil
+	return name
 }
 
 func Save(c Config) error {
@@ -0,0 +1,165 @@
+package relayerconf
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/starport/starport/pkg/confile"
+)
+
+func testConfig() Config {
+	return Config{
+		Version: supportVersion,
+		Chains: []Chain{
+			{ID: "mars"},
+			{ID: "venus"},
+			{ID: "earth"},
+		},
+		Paths: []Path{
+			{ID: "mars-venus", Src: PathEnd{ChainID: "mars"}, Dst: PathEnd{ChainID: "venus"}},
+		},
+	}
+}
+
+func TestConfigPaths(t *testing.T) {
+	c := testConfig()
+
+	require.NoError(t, c.RenamePath("mars-venus", "mv"))
+	_, err := c.PathByID("mv")
+	require.NoError(t, err)
+
+	err = c.AddPath(Path{ID: "mv", Src: PathEnd{ChainID: "mars"}, Dst: PathEnd{ChainID: "earth"}})
+	require.True(t, errors.Is(err, ErrPathAlreadyExists))
+
+	err = c.AddPath(Path{ID: "mars-moon", Src: PathEnd{ChainID: "mars"}, Dst: PathEnd{ChainID: "moon"}})
+	require.True(t, errors.Is(err, ErrChainCannotBeFound))
+
+	require.NoError(t, c.AddPath(Path{ID: "me", Src: PathEnd{ChainID: "mars"}, Dst: PathEnd{ChainID: "earth"}}))
+	require.NoError(t, c.RemovePath("mv"))
+	require.Len(t, c.Paths, 1)
+	require.Equal(t, "me", c.Paths[0].ID)
+}
+
+func TestConfigRemoveChain(t *testing.T) {
+	c := testConfig()
+
+	err := c.RemoveChain("venus")
+	require.True(t, errors.Is(err, ErrChainInUse))
+
+	require.NoError(t, c.RemoveChain("earth"))
+	require.Len(t, c.Chains, 2)
+	require.NoError(t, c.Validate())
+}
+
+func TestConfigExportImport(t *testing.T) {
+	exported, err := testConfig().Export("mars-venus")
+	require.NoError(t, err)
+	require.Len(t, exported.Chains, 2)
+
+	c := Config{Chains: []Chain{{ID: "mars", RPCAddress: "http://mars"}}}
+	require.NoError(t, c.Import(exported))
+	require.Len(t, c.Chains, 2)
+	require.Equal(t, "http://mars", c.Chains[0].RPCAddress)
+	require.NoError(t, c.Validate())
+
+	require.Error(t, c.Import(Config{Version: supportVersion, Paths: []Path{{ID: "p", Src: PathEnd{ChainID: "moon"}}}}))
+}
+
+func TestConfigExportImportBackends(t *testing.T) {
+	for _, backend := range []string{BackendTSRelayer, BackendGo} {
+		t.Run(backend, func(t *testing.T) {
+			c := testConfig()
+			c.Backend = backend
+			c.Paths[0].Src.ConnectionID = "connection-0"
+			c.Paths[0].Dst.ConnectionID = "connection-0"
+
+			exported, err := c.Export("mars-venus")
+			require.NoError(t, err)
+			require.Equal(t, backend, exported.Backend)
+
+			// exported paths are imported from the file written by export.
+			exportPath := filepath.Join(t.TempDir(), "exported.yml")
+			require.NoError(t, confile.New(confile.DefaultYAMLEncodingCreator, exportPath).Save(exported))
+
+			var imported Config
+			require.NoError(t, confile.New(confile.DefaultYAMLEncodingCreator, exportPath).Load(&imported))
+			require.Equal(t, exported, imported)
+
+			same := Config{Version: supportVersion, Backend: backend}
+			require.NoError(t, same.Import(imported))
+			path, err := same.PathByID("mars-venus")
+			require.NoError(t, err)
+			require.Equal(t, c.Paths[0], path)
+
+			// linked paths cannot be relayed by another backend.
+			other := Config{Version: supportVersion, Backend: BackendGo}
+			if backend == BackendGo {
+				other.Backend = BackendTSRelayer
+			}
+			require.Error(t, other.Import(imported))
+		})
+	}
+}
+
+func TestConfigImportVersions(t *testing.T) {
+	imported := func(version string) Config {
+		return Config{
+			Version: version,
+			Chains:  []Chain{{ID: "moon"}, {ID: "sun"}},
+			Paths: []Path{
+				{ID: "moon-sun", Src: PathEnd{ChainID: "moon", ConnectionID: "connection-0"}, Dst: PathEnd{ChainID: "sun"}},
+			},
+		}
+	}
+
+	c := testConfig()
+	err := c.Import(imported("4"))
+	require.True(t, errors.Is(err, ErrNewerVersion))
+
+	err = c.Import(imported("1"))
+	require.True(t, errors.Is(err, ErrOutdatedVersion))
+
+	// linked v2 paths are relayed by the ts relayer after they are migrated.
+	c.Backend = BackendGo
+	require.Error(t, c.Import(imported("2")))
+
+	c.Backend = ""
+	require.NoError(t, c.Import(imported("2")))
+	_, err = c.PathByID("moon-sun")
+	require.NoError(t, err)
+}
+
+func TestGetMigrates(t *testing.T) {
+	configPath = filepath.Join(t.TempDir(), "config.yml")
+
+	err := os.WriteFile(configPath, []byte(`version: "2"
+chains:
+- id: mars
+- id: venus
+paths:
+- id: mars-venus
+  src:
+    chain_id: mars
+    connection_id: connection-0
+  dst:
+    chain_id: venus
+    connection_id: connection-0
+`), 0644)
+	require.NoError(t, err)
+
+	c, err := Get()
+	require.NoError(t, err)
+	require.Equal(t, supportVersion, c.Version)
+	require.Equal(t, BackendTSRelayer, c.Backend)
+
+	saved, err := Get()
+	require.NoError(t, err)
+	require.Equal(t, c.Backend, saved.Backend)
+
+	require.NoError(t, os.WriteFile(configPath, []byte(`version: "1"`), 0644))
+	_, err = Get()
+	require.Error(t, err)
+}
@@ -0,0 +1,223 @@
+package gorelayer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	clienttypes "github.com/cosmos/ibc-go/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/modules/core/23-commitment/types"
+	"github.com/cosmos/ibc-go/modules/core/exported"
+	ibctmtypes "github.com/cosmos/ibc-go/modules/light-clients/07-tendermint/types"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+const (
+	// ibcStorePath is the abci query path of the ibc store.
+	ibcStorePath = "store/ibc/key"
+
+	// validatorsPerPage is the number of validators fetched per validators query.
+	validatorsPerPage = 100
+
+	// heightPollInterval is the interval to check whether a chain reached a height.
+	heightPollInterval = time.Millisecond * 500
+)
+
+// address returns the address of the relayer account on the chain.
+func (c Chain) address() string {
+	return c.Account.Address(c.AddressPrefix)
+}
+
+// height converts a block height of the chain to an ibc height.
+func (c Chain) height(height int64) clienttypes.Height {
+	return clienttypes.NewHeight(clienttypes.ParseChainID(c.ID), uint64(height))
+}
+
+// send broadcasts a tx with msgs signed by the relayer account.
+func (c Chain) send(ctx context.Context, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+	res, err := c.Client.BroadcastTx(ctx, c.Account.Name, msgs...)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", c.ID, err)
+	}
+	return res, nil
+}
+
+// latestHeight returns the latest block height of the chain.
+func (c Chain) latestHeight(ctx context.Context) (int64, error) {
+	status, err := c.Client.RPC.Status(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return status.SyncInfo.LatestBlockHeight, nil
+}
+
+// waitForHeight blocks until the chain reaches height.
+func (c Chain) waitForHeight(ctx context.Context, height int64) error {
+	ticker := time.NewTicker(heightPollInterval)
+	defer ticker.Stop()
+
+	for {
+		latest, err := c.latestHeight(ctx)
+		if err != nil {
+			return err
+		}
+		if latest >= height {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// waitForTx waits until the state changes made by the tx in res are provable.
+func (c Chain) waitForTx(ctx context.Context, res *sdk.TxResponse) error {
+	return c.waitForHeight(ctx, res.Height+1)
+}
+
+// validatorSet returns the validator set of the chain at height.
+func (c Chain) validatorSet(ctx context.Context, height int64) (*tmtypes.ValidatorSet, error) {
+	var (
+		page       = 1
+		perPage    = validatorsPerPage
+		validators []*tmtypes.Validator
+	)
+
+	for {
+		res, err := c.Client.RPC.Validators(ctx, &height, &page, &perPage)
+		if err != nil {
+			return nil, err
+		}
+		validators = append(validators, res.Validators...)
+		if len(validators) >= res.Total || len(res.Validators) == 0 {
+			break
+		}
+		page++
+	}
+
+	return tmtypes.NewValidatorSet(validators), nil
+}
+
+// header returns the light client header of the chain at height.
+func (c Chain) header(ctx context.Context, height int64) (*ibctmtypes.Header, error) {
+	commit, err := c.Client.RPC.Commit(ctx, &height)
+	if err != nil {
+		return nil, err
+	}
+
+	valSet, err := c.validatorSet(ctx, height)
+	if err != nil {
+		return nil, err
+	}
+	protoValSet, err := valSet.ToProto()
+	if err != nil {
+		return nil, err
+	}
+
+	return &ibctmtypes.Header{
+		SignedHeader: commit.SignedHeader.ToProto(),
+		ValidatorSet: protoValSet,
+	}, nil
+}
+
+// updateHeader returns the header to update a client, that is at trustedHeight, to height.
+func (c Chain) updateHeader(ctx context.Context, trustedHeight exported.Height, height int64) (*ibctmtypes.Header, error) {
+	header, err := c.header(ctx, height)
+	if err != nil {
+		return nil, err
+	}
+
+	// the validators of the trusted header are the next validators of the trusted height.
+	trustedValSet, err := c.validatorSet(ctx, int64(trustedHeight.GetRevisionHeight())+1)
+	if err != nil {
+		return nil, err
+	}
+	if header.TrustedValidators, err = trustedValSet.ToProto(); err != nil {
+		return nil, err
+	}
+	header.TrustedHeight = clienttypes.NewHeight(trustedHeight.GetRevisionNumber(), trustedHeight.GetRevisionHeight())
+
+	return header, nil
+}
+
+// unbondingPeriod returns the unbonding period of the chain.
+func (c Chain) unbondingPeriod(ctx context.Context) (time.Duration, error) {
+	res, err := stakingtypes.NewQueryClient(c.Client.Context).Params(ctx, &stakingtypes.QueryParamsRequest{})
+	if err != nil {
+		return 0, err
+	}
+	return res.Params.UnbondingTime, nil
+}
+
+// query queries the value of key in the ibc store with its proof. The proof is made against
+// the app hash of the block at height, so it can be verified by a client that is at height.
+func (c Chain) query(ctx context.Context, key []byte, height int64) (value, proof []byte, proofHeight clienttypes.Height, err error) {
+	res, err := c.Client.RPC.ABCIQueryWithOptions(ctx, ibcStorePath, key, rpcclient.ABCIQueryOptions{
+		Height: height - 1,
+		Prove:  true,
+	})
+	if err != nil {
+		return nil, nil, clienttypes.Height{}, err
+	}
+	if !res.Response.IsOK() {
+		return nil, nil, clienttypes.Height{}, fmt.Errorf("%s: cannot query %q: %s", c.ID, key, res.Response.Log)
+	}
+
+	merkleProof, err := commitmenttypes.ConvertProofs(res.Response.ProofOps)
+	if err != nil {
+		return nil, nil, clienttypes.Height{}, err
+	}
+	if proof, err = c.Client.Context.Codec.Marshal(&merkleProof); err != nil {
+		return nil, nil, clienttypes.Height{}, err
+	}
+
+	// state changes of a block are committed to the app hash of the next block.
+	return res.Response.Value, proof, c.height(res.Response.Height + 1), nil
+}
+
+// clientState returns the state of client.
+func (c Chain) clientState(ctx context.Context, clientID string) (exported.ClientState, error) {
+	res, err := clienttypes.NewQueryClient(c.Client.Context).ClientState(ctx, &clienttypes.QueryClientStateRequest{
+		ClientId: clientID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return clienttypes.UnpackClientState(res.ClientState)
+}
+
+// latestConsensusState returns the consensus state of client at its latest height.
+func (c Chain) latestConsensusState(ctx context.Context, clientID string) (exported.ConsensusState, error) {
+	res, err := clienttypes.NewQueryClient(c.Client.Context).ConsensusState(ctx, &clienttypes.QueryConsensusStateRequest{
+		ClientId:     clientID,
+		LatestHeight: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return clienttypes.UnpackConsensusState(res.ConsensusState)
+}
+
+// eventAttribute returns the value of the first attribute with key in the first event of eventType
+// emitted by the tx in res.
+func eventAttribute(res *sdk.TxResponse, eventType, key string) (string, error) {
+	for _, log := range res.Logs {
+		for _, event := range log.Events {
+			if event.Type != eventType {
+				continue
+			}
+			for _, attr := range event.Attributes {
+				if attr.Key == key {
+					return attr.Value, nil
+				}
+			}
+		}
+	}
+	return "", fmt.Errorf("no %q attribute in %q events of tx %s", key, eventType, res.TxHash)
+}
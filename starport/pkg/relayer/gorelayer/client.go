@@ -0,0 +1,201 @@
+package gorelayer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
+	ibctmtypes "github.com/cosmos/ibc-go/modules/light-clients/07-tendermint/types"
+	relayerconf "github.com/tendermint/starport/starport/pkg/relayer/config"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ErrLinkGone is returned when a linked path cannot be relayed anymore because its clients
+// are expired or its connections, clients or channels don't exist on the chains anymore.
+var ErrLinkGone = errors.New("link is gone")
+
+// clientRefreshRate is the fraction of the trusting period after which clients are refreshed,
+// refreshing a few times in a trusting period leaves room for the failed refreshes.
+const clientRefreshRate = 3
+
+// ClientStatus is the status of a client that tracks a chain on another chain.
+type ClientStatus struct {
+	// ChainID is the id of the chain that hosts the client.
+	ChainID string
+
+	// ClientID is the id of the client.
+	ClientID string
+
+	// CounterpartyChainID is the id of the chain tracked by the client.
+	CounterpartyChainID string
+
+	// LatestHeight is the latest height of the counterparty chain known by the client.
+	LatestHeight uint64
+
+	// UpdatedAt is the time of the latest header of the counterparty chain known by the client.
+	UpdatedAt time.Time
+
+	// TrustingPeriod is the period that the latest header is trusted for.
+	// it is zero when it is unknown, e.g. for clients other than Tendermint clients.
+	TrustingPeriod time.Duration
+}
+
+// ExpiresAt returns the time that the client expires unless it is updated.
+// it returns the zero time when the trusting period is unknown.
+func (s ClientStatus) ExpiresAt() time.Time {
+	if s.TrustingPeriod == 0 {
+		return time.Time{}
+	}
+	return s.UpdatedAt.Add(s.TrustingPeriod)
+}
+
+// IsExpired checks if the client is expired at t. expired clients cannot be updated anymore.
+// clients with an unknown trusting period are assumed to never expire.
+func (s ClientStatus) IsExpired(t time.Time) bool {
+	if s.TrustingPeriod == 0 {
+		return false
+	}
+	return !t.Before(s.ExpiresAt())
+}
+
+// RefreshAt returns the time that the client needs to be updated to not get close to expiring.
+// it returns the zero time when the trusting period is unknown, such clients are not refreshed.
+func (s ClientStatus) RefreshAt() time.Time {
+	if s.TrustingPeriod == 0 {
+		return time.Time{}
+	}
+	return s.UpdatedAt.Add(s.TrustingPeriod / clientRefreshRate)
+}
+
+// Clients returns the status of the clients on both ends of a linked path.
+func Clients(ctx context.Context, path relayerconf.Path, src, dst Chain) ([]ClientStatus, error) {
+	l, err := loadLink(ctx, path, src, dst)
+	if err != nil {
+		return nil, err
+	}
+	return l.clients(ctx)
+}
+
+// CheckLink checks that a linked path can still be relayed. it returns ErrLinkGone when the path
+// needs to be linked again, other errors don't tell anything about the state of the link.
+func CheckLink(ctx context.Context, path relayerconf.Path, src, dst Chain) error {
+	l, err := loadLink(ctx, path, src, dst)
+	if err != nil {
+		return linkErr(err)
+	}
+
+	for _, e := range []*end{l.src, l.dst} {
+		if _, err := channeltypes.NewQueryClient(e.Client.Context).Channel(ctx, &channeltypes.QueryChannelRequest{
+			PortId:    e.portID,
+			ChannelId: e.channelID,
+		}); err != nil {
+			return linkErr(err)
+		}
+	}
+
+	clients, err := l.clients(ctx)
+	if err != nil {
+		return linkErr(err)
+	}
+
+	now := time.Now()
+
+	for _, client := range clients {
+		if client.IsExpired(now) {
+			return fmt.Errorf("%w: client %s on %q expired at %s", ErrLinkGone, client.ClientID, client.ChainID,
+				client.ExpiresAt().Format(time.RFC3339))
+		}
+	}
+
+	return nil
+}
+
+// linkErr wraps err with ErrLinkGone if it is returned for a missing connection, client or channel.
+func linkErr(err error) error {
+	if status.Code(err) == codes.NotFound {
+		return fmt.Errorf("%w: %s", ErrLinkGone, err)
+	}
+	return err
+}
+
+// RefreshClients updates the clients of a linked path that are due to be refreshed and
+// returns the status of the clients after they are refreshed. expired clients are left as is.
+func RefreshClients(ctx context.Context, path relayerconf.Path, src, dst Chain) ([]ClientStatus, error) {
+	l, err := loadLink(ctx, path, src, dst)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	for _, ends := range [][2]*end{{l.src, l.dst}, {l.dst, l.src}} {
+		e, counterparty := ends[0], ends[1]
+
+		status, err := e.clientStatus(ctx, counterparty.Chain)
+		if err != nil {
+			return nil, err
+		}
+		refreshAt := status.RefreshAt()
+		if refreshAt.IsZero() || now.Before(refreshAt) || status.IsExpired(now) {
+			continue
+		}
+
+		msgs, _, err := e.updateClient(ctx, counterparty.Chain)
+		if err != nil {
+			return nil, err
+		}
+		if len(msgs) == 0 {
+			continue
+		}
+		if _, err := e.send(ctx, msgs...); err != nil {
+			return nil, err
+		}
+	}
+
+	return l.clients(ctx)
+}
+
+// clients returns the status of the clients on both ends.
+func (l link) clients(ctx context.Context) ([]ClientStatus, error) {
+	src, err := l.src.clientStatus(ctx, l.dst.Chain)
+	if err != nil {
+		return nil, err
+	}
+
+	dst, err := l.dst.clientStatus(ctx, l.src.Chain)
+	if err != nil {
+		return nil, err
+	}
+
+	return []ClientStatus{src, dst}, nil
+}
+
+// clientStatus returns the status of the client on e that tracks counterparty.
+func (e *end) clientStatus(ctx context.Context, counterparty Chain) (ClientStatus, error) {
+	clientState, err := e.clientState(ctx, e.clientID)
+	if err != nil {
+		return ClientStatus{}, err
+	}
+
+	consensusState, err := e.latestConsensusState(ctx, e.clientID)
+	if err != nil {
+		return ClientStatus{}, err
+	}
+
+	status := ClientStatus{
+		ChainID:             e.ID,
+		ClientID:            e.clientID,
+		CounterpartyChainID: counterparty.ID,
+		LatestHeight:        clientState.GetLatestHeight().GetRevisionHeight(),
+		UpdatedAt:           time.Unix(0, int64(consensusState.GetTimestamp())),
+	}
+
+	if tmClientState, ok := clientState.(*ibctmtypes.ClientState); ok {
+		status.TrustingPeriod = tmClientState.TrustingPeriod
+	}
+
+	return status, nil
+}
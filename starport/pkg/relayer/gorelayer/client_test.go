@@ -0,0 +1,26 @@
+package gorelayer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClientStatus(t *testing.T) {
+	updatedAt := time.Now()
+	status := ClientStatus{UpdatedAt: updatedAt, TrustingPeriod: time.Hour * 3}
+
+	require.Equal(t, updatedAt.Add(time.Hour*3), status.ExpiresAt())
+	require.Equal(t, updatedAt.Add(time.Hour), status.RefreshAt())
+	require.False(t, status.IsExpired(updatedAt.Add(time.Hour)))
+	require.True(t, status.IsExpired(updatedAt.Add(time.Hour*3)))
+
+	// clients with an unknown trusting period never expire and are not refreshed.
+	status = ClientStatus{UpdatedAt: updatedAt}
+
+	require.True(t, status.ExpiresAt().IsZero())
+	require.True(t, status.RefreshAt().IsZero())
+	require.False(t, status.IsExpired(updatedAt))
+	require.False(t, status.IsExpired(updatedAt.Add(time.Hour*24*365)))
+}
@@ -0,0 +1,121 @@
+package gorelayer
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
+	relayerconf "github.com/tendermint/starport/starport/pkg/relayer/config"
+)
+
+// filter selects the packets to relay.
+type filter struct {
+	relayerconf.Filter
+
+	minFee sdk.Coins
+}
+
+// newFilter validates and creates a filter from its config for a channel with order.
+func newFilter(conf relayerconf.Filter, order channeltypes.Order) (filter, error) {
+	f := filter{Filter: conf}
+
+	// packets of ordered channels are received in sequence, a packet that is never relayed
+	// nor timed out would block all the packets sent after it.
+	if order == channeltypes.ORDERED && !conf.IsEmpty() {
+		return filter{}, errors.New("packet filters cannot be used with ordered channels")
+	}
+
+	var err error
+	if f.minFee, err = sdk.ParseCoinsNormalized(conf.MinFee); err != nil {
+		return filter{}, fmt.Errorf("invalid min fee %q: %w", conf.MinFee, err)
+	}
+
+	for _, patterns := range [][]string{conf.Senders, conf.Receivers} {
+		for _, pattern := range patterns {
+			if _, err := path.Match(pattern, ""); err != nil {
+				return filter{}, fmt.Errorf("invalid address pattern %q: %w", pattern, err)
+			}
+		}
+	}
+
+	return f, nil
+}
+
+// apply returns the packets that are allowed by the filter.
+func (f filter) apply(packets []packet) []packet {
+	if f.IsEmpty() {
+		return packets
+	}
+
+	var allowed []packet
+	for _, p := range packets {
+		if f.allows(p) {
+			allowed = append(allowed, p)
+		}
+	}
+	return allowed
+}
+
+// allows checks if packet p is allowed by the filter.
+func (f filter) allows(p packet) bool {
+	if !allowsAny(f.AllowChannels, f.DenyChannels, p.SourceChannel, p.DestinationChannel) ||
+		!allowsAny(f.AllowPorts, f.DenyPorts, p.SourcePort, p.DestinationPort) {
+		return false
+	}
+
+	if len(f.Senders) > 0 || len(f.Receivers) > 0 {
+		// the addresses are read from the packet data by using the field names of ICS-20 packets.
+		var data struct {
+			Sender   string `json:"sender"`
+			Receiver string `json:"receiver"`
+		}
+		if err := json.Unmarshal(p.Data, &data); err != nil {
+			return false
+		}
+		if !matchesAny(f.Senders, data.Sender) || !matchesAny(f.Receivers, data.Receiver) {
+			return false
+		}
+	}
+
+	return f.minFee.Empty() || p.fee.IsAllGTE(f.minFee)
+}
+
+// allowsAny checks that none of the values are in deny and, unless allow is empty, some are in allow.
+func allowsAny(allow, deny []string, values ...string) bool {
+	for _, value := range values {
+		for _, denied := range deny {
+			if value == denied {
+				return false
+			}
+		}
+	}
+
+	if len(allow) == 0 {
+		return true
+	}
+
+	for _, value := range values {
+		for _, allowed := range allow {
+			if value == allowed {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// matchesAny checks if s matches with one of the patterns, any s matches when there are no patterns.
+func matchesAny(patterns []string, s string) bool {
+	if len(patterns) == 0 {
+		return true
+	}
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(pattern, s); ok {
+			return true
+		}
+	}
+	return false
+}
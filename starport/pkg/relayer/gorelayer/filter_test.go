@@ -0,0 +1,94 @@
+package gorelayer
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+	relayerconf "github.com/tendermint/starport/starport/pkg/relayer/config"
+)
+
+func TestFilter(t *testing.T) {
+	newPacket := func(channelID, sender string, fee sdk.Coins) packet {
+		return packet{
+			Packet: channeltypes.Packet{
+				SourcePort:         "transfer",
+				SourceChannel:      channelID,
+				DestinationPort:    "transfer",
+				DestinationChannel: "channel-9",
+				Data:               []byte(`{"sender":"` + sender + `","receiver":"cosmos1bob"}`),
+			},
+			fee: fee,
+		}
+	}
+
+	cases := []struct {
+		name    string
+		filter  relayerconf.Filter
+		packet  packet
+		allowed bool
+	}{
+		{
+			name:    "empty filter",
+			packet:  newPacket("channel-0", "cosmos1alice", nil),
+			allowed: true,
+		},
+		{
+			name:    "allowed channel",
+			filter:  relayerconf.Filter{AllowChannels: []string{"channel-0"}},
+			packet:  newPacket("channel-0", "cosmos1alice", nil),
+			allowed: true,
+		},
+		{
+			name:   "not allowed channel",
+			filter: relayerconf.Filter{AllowChannels: []string{"channel-1"}},
+			packet: newPacket("channel-0", "cosmos1alice", nil),
+		},
+		{
+			name:   "denied port",
+			filter: relayerconf.Filter{DenyPorts: []string{"transfer"}},
+			packet: newPacket("channel-0", "cosmos1alice", nil),
+		},
+		{
+			name:    "matching sender",
+			filter:  relayerconf.Filter{Senders: []string{"cosmos1a*"}, Receivers: []string{"cosmos1bob"}},
+			packet:  newPacket("channel-0", "cosmos1alice", nil),
+			allowed: true,
+		},
+		{
+			name:   "not matching sender",
+			filter: relayerconf.Filter{Senders: []string{"cosmos1b*"}},
+			packet: newPacket("channel-0", "cosmos1alice", nil),
+		},
+		{
+			name:    "enough fee",
+			filter:  relayerconf.Filter{MinFee: "10stake"},
+			packet:  newPacket("channel-0", "cosmos1alice", sdk.NewCoins(sdk.NewInt64Coin("stake", 10))),
+			allowed: true,
+		},
+		{
+			name:   "low fee",
+			filter: relayerconf.Filter{MinFee: "10stake"},
+			packet: newPacket("channel-0", "cosmos1alice", sdk.NewCoins(sdk.NewInt64Coin("stake", 9))),
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := newFilter(tt.filter, channeltypes.UNORDERED)
+			require.NoError(t, err)
+			require.Equal(t, tt.allowed, len(f.apply([]packet{tt.packet})) == 1)
+		})
+	}
+
+	_, err := newFilter(relayerconf.Filter{Senders: []string{"["}}, channeltypes.UNORDERED)
+	require.Error(t, err)
+
+	// filtered packets would block ordered channels.
+	_, err = newFilter(relayerconf.Filter{MinFee: "100stake"}, channeltypes.ORDERED)
+	require.Error(t, err)
+
+	_, err = newFilter(relayerconf.Filter{}, channeltypes.ORDERED)
+	require.NoError(t, err)
+}
@@ -0,0 +1,129 @@
+// Package gorelayer is a native IBC relayer that links chains and relays packets in between
+// by using cosmosclient.
+package gorelayer
+
+import (
+	"context"
+
+	"github.com/tendermint/starport/starport/pkg/cosmosaccount"
+	"github.com/tendermint/starport/starport/pkg/cosmosclient"
+	relayerconf "github.com/tendermint/starport/starport/pkg/relayer/config"
+)
+
+// Chain is a chain to relay for.
+type Chain struct {
+	// ID is the chain id.
+	ID string
+
+	// Client is used to query and send transactions to the chain.
+	Client cosmosclient.Client
+
+	// Account is the account that signs the transactions sent to the chain.
+	Account cosmosaccount.Account
+
+	// AddressPrefix is the address prefix of the chain.
+	AddressPrefix string
+}
+
+// NewChain creates a chain with client and account.
+func NewChain(client cosmosclient.Client, account cosmosaccount.Account, addressPrefix string) Chain {
+	return Chain{
+		ID:            client.Context.ChainID,
+		Client:        client,
+		Account:       account,
+		AddressPrefix: addressPrefix,
+	}
+}
+
+// Link creates clients, a connection and a channel between src and dst chains for path
+// and returns the path with the connection and channel ids filled.
+func Link(ctx context.Context, path relayerconf.Path, src, dst Chain) (relayerconf.Path, error) {
+	order, err := parseOrder(path.Ordering)
+	if err != nil {
+		return relayerconf.Path{}, err
+	}
+
+	// the filter is checked before linking to not create a channel that cannot be relayed.
+	if _, err := newFilter(path.Filter, order); err != nil {
+		return relayerconf.Path{}, err
+	}
+
+	l := link{
+		src:   &end{Chain: src, portID: path.Src.PortID, version: path.Src.Version},
+		dst:   &end{Chain: dst, portID: path.Dst.PortID, version: path.Dst.Version},
+		order: order,
+	}
+
+	if err := l.createClients(ctx); err != nil {
+		return relayerconf.Path{}, err
+	}
+	if err := l.openConnection(ctx); err != nil {
+		return relayerconf.Path{}, err
+	}
+	if err := l.openChannel(ctx); err != nil {
+		return relayerconf.Path{}, err
+	}
+
+	path.Src.ConnectionID = l.src.connectionID
+	path.Src.ChannelID = l.src.channelID
+	path.Dst.ConnectionID = l.dst.connectionID
+	path.Dst.ChannelID = l.dst.channelID
+
+	return path, nil
+}
+
+// Relay relays the packets and acknowledgements of a linked path in both directions
+// that are allowed by the path's filter starting from the heights saved in path and
+// returns the path with the heights and the relay counts updated.
+func Relay(ctx context.Context, path relayerconf.Path, src, dst Chain) (relayerconf.Path, error) {
+	l, err := loadLink(ctx, path, src, dst)
+	if err != nil {
+		return relayerconf.Path{}, err
+	}
+
+	var n int
+
+	if path.Src.PacketHeight, n, err = l.relayPackets(ctx, l.src, l.dst, path.Src.PacketHeight); err != nil {
+		return relayerconf.Path{}, err
+	}
+	path.Src.PacketsRelayed += int64(n)
+
+	if path.Dst.PacketHeight, n, err = l.relayPackets(ctx, l.dst, l.src, path.Dst.PacketHeight); err != nil {
+		return relayerconf.Path{}, err
+	}
+	path.Dst.PacketsRelayed += int64(n)
+
+	if path.Dst.AckHeight, n, err = l.relayAcks(ctx, l.dst, l.src, path.Dst.AckHeight); err != nil {
+		return relayerconf.Path{}, err
+	}
+	path.Src.AcksRelayed += int64(n)
+
+	if path.Src.AckHeight, n, err = l.relayAcks(ctx, l.src, l.dst, path.Src.AckHeight); err != nil {
+		return relayerconf.Path{}, err
+	}
+	path.Dst.AcksRelayed += int64(n)
+
+	return path, nil
+}
+
+// loadLink loads the link of a linked path.
+func loadLink(ctx context.Context, path relayerconf.Path, src, dst Chain) (link, error) {
+	order, err := parseOrder(path.Ordering)
+	if err != nil {
+		return link{}, err
+	}
+
+	filter, err := newFilter(path.Filter, order)
+	if err != nil {
+		return link{}, err
+	}
+
+	l := link{
+		src:    &end{Chain: src, portID: path.Src.PortID, connectionID: path.Src.ConnectionID, channelID: path.Src.ChannelID},
+		dst:    &end{Chain: dst, portID: path.Dst.PortID, connectionID: path.Dst.ConnectionID, channelID: path.Dst.ChannelID},
+		order:  order,
+		filter: filter,
+	}
+
+	return l, l.loadClients(ctx)
+}
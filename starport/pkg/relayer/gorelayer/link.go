@@ -0,0 +1,348 @@
+package gorelayer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	clienttypes "github.com/cosmos/ibc-go/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/modules/core/24-host"
+	"github.com/cosmos/ibc-go/modules/core/exported"
+	ibctmtypes "github.com/cosmos/ibc-go/modules/light-clients/07-tendermint/types"
+)
+
+// maxClockDrift is the max clock drift allowed between chains.
+const maxClockDrift = time.Second * 10
+
+var (
+	// commitmentPrefix is the prefix of the ibc store.
+	commitmentPrefix = commitmenttypes.NewMerklePrefix([]byte(host.StoreKey))
+
+	// upgradePath is the path of the upgraded ibc state in the upgrade store.
+	upgradePath = []string{upgradetypes.StoreKey, upgradetypes.KeyUpgradedIBCState}
+)
+
+// end is an end of a link.
+type end struct {
+	Chain
+
+	// clientID is the id of the client that tracks the counterparty chain.
+	clientID     string
+	connectionID string
+	channelID    string
+	portID       string
+	version      string
+}
+
+// link is a link between two chains.
+type link struct {
+	src, dst *end
+	order    channeltypes.Order
+	filter   filter
+}
+
+// createClients creates a client for the counterparty chain on both ends.
+func (l link) createClients(ctx context.Context) error {
+	if err := l.src.createClient(ctx, l.dst.Chain); err != nil {
+		return err
+	}
+	return l.dst.createClient(ctx, l.src.Chain)
+}
+
+// loadClients loads the client ids of the both ends from their connections.
+func (l link) loadClients(ctx context.Context) error {
+	for _, e := range []*end{l.src, l.dst} {
+		res, err := connectiontypes.NewQueryClient(e.Client.Context).Connection(ctx, &connectiontypes.QueryConnectionRequest{
+			ConnectionId: e.connectionID,
+		})
+		if err != nil {
+			return err
+		}
+		e.clientID = res.Connection.ClientId
+	}
+	return nil
+}
+
+// openConnection opens a connection between the ends with a connection handshake.
+func (l link) openConnection(ctx context.Context) error {
+	var (
+		src, dst = l.src, l.dst
+		versions = connectiontypes.ExportedVersionsToProto(connectiontypes.GetCompatibleVersions())
+	)
+
+	res, err := src.send(ctx, connectiontypes.NewMsgConnectionOpenInit(
+		src.clientID, dst.clientID, commitmentPrefix, connectiontypes.DefaultIBCVersion, 0, src.address(),
+	))
+	if err != nil {
+		return err
+	}
+	if src.connectionID, err = eventAttribute(res, connectiontypes.EventTypeConnectionOpenInit,
+		connectiontypes.AttributeKeyConnectionID); err != nil {
+		return err
+	}
+	if err := src.waitForTx(ctx, res); err != nil {
+		return err
+	}
+
+	res, err = dst.sendWithUpdate(ctx, src.Chain, func(height int64) ([]sdk.Msg, error) {
+		p, err := src.proveConnection(ctx, height)
+		if err != nil {
+			return nil, err
+		}
+		return []sdk.Msg{connectiontypes.NewMsgConnectionOpenTry(
+			"", dst.clientID, src.connectionID, src.clientID, p.clientState, commitmentPrefix, versions, 0,
+			p.connection, p.client, p.consensus, p.height, p.consensusHeight, dst.address(),
+		)}, nil
+	})
+	if err != nil {
+		return err
+	}
+	if dst.connectionID, err = eventAttribute(res, connectiontypes.EventTypeConnectionOpenTry,
+		connectiontypes.AttributeKeyConnectionID); err != nil {
+		return err
+	}
+	if err := dst.waitForTx(ctx, res); err != nil {
+		return err
+	}
+
+	res, err = src.sendWithUpdate(ctx, dst.Chain, func(height int64) ([]sdk.Msg, error) {
+		p, err := dst.proveConnection(ctx, height)
+		if err != nil {
+			return nil, err
+		}
+		return []sdk.Msg{connectiontypes.NewMsgConnectionOpenAck(
+			src.connectionID, dst.connectionID, p.clientState, p.connection, p.client, p.consensus,
+			p.height, p.consensusHeight, connectiontypes.DefaultIBCVersion, src.address(),
+		)}, nil
+	})
+	if err != nil {
+		return err
+	}
+	if err := src.waitForTx(ctx, res); err != nil {
+		return err
+	}
+
+	_, err = dst.sendWithUpdate(ctx, src.Chain, func(height int64) ([]sdk.Msg, error) {
+		_, proof, proofHeight, err := src.query(ctx, host.ConnectionKey(src.connectionID), height)
+		if err != nil {
+			return nil, err
+		}
+		return []sdk.Msg{connectiontypes.NewMsgConnectionOpenConfirm(dst.connectionID, proof, proofHeight, dst.address())}, nil
+	})
+	return err
+}
+
+// openChannel opens a channel between the ends with a channel handshake.
+func (l link) openChannel(ctx context.Context) error {
+	src, dst, order := l.src, l.dst, l.order
+
+	res, err := src.send(ctx, channeltypes.NewMsgChannelOpenInit(
+		src.portID, src.version, order, []string{src.connectionID}, dst.portID, src.address(),
+	))
+	if err != nil {
+		return err
+	}
+	if src.channelID, err = eventAttribute(res, channeltypes.EventTypeChannelOpenInit,
+		channeltypes.AttributeKeyChannelID); err != nil {
+		return err
+	}
+	if err := src.waitForTx(ctx, res); err != nil {
+		return err
+	}
+
+	res, err = dst.sendWithUpdate(ctx, src.Chain, func(height int64) ([]sdk.Msg, error) {
+		_, proof, proofHeight, err := src.query(ctx, host.ChannelKey(src.portID, src.channelID), height)
+		if err != nil {
+			return nil, err
+		}
+		return []sdk.Msg{channeltypes.NewMsgChannelOpenTry(
+			dst.portID, "", dst.version, order, []string{dst.connectionID}, src.portID, src.channelID,
+			src.version, proof, proofHeight, dst.address(),
+		)}, nil
+	})
+	if err != nil {
+		return err
+	}
+	if dst.channelID, err = eventAttribute(res, channeltypes.EventTypeChannelOpenTry,
+		channeltypes.AttributeKeyChannelID); err != nil {
+		return err
+	}
+	if err := dst.waitForTx(ctx, res); err != nil {
+		return err
+	}
+
+	res, err = src.sendWithUpdate(ctx, dst.Chain, func(height int64) ([]sdk.Msg, error) {
+		_, proof, proofHeight, err := dst.query(ctx, host.ChannelKey(dst.portID, dst.channelID), height)
+		if err != nil {
+			return nil, err
+		}
+		return []sdk.Msg{channeltypes.NewMsgChannelOpenAck(
+			src.portID, src.channelID, dst.channelID, dst.version, proof, proofHeight, src.address(),
+		)}, nil
+	})
+	if err != nil {
+		return err
+	}
+	if err := src.waitForTx(ctx, res); err != nil {
+		return err
+	}
+
+	_, err = dst.sendWithUpdate(ctx, src.Chain, func(height int64) ([]sdk.Msg, error) {
+		_, proof, proofHeight, err := src.query(ctx, host.ChannelKey(src.portID, src.channelID), height)
+		if err != nil {
+			return nil, err
+		}
+		return []sdk.Msg{channeltypes.NewMsgChannelOpenConfirm(dst.portID, dst.channelID, proof, proofHeight, dst.address())}, nil
+	})
+	return err
+}
+
+// createClient creates a client on e that tracks counterparty.
+func (e *end) createClient(ctx context.Context, counterparty Chain) error {
+	height, err := counterparty.latestHeight(ctx)
+	if err != nil {
+		return err
+	}
+
+	header, err := counterparty.header(ctx, height)
+	if err != nil {
+		return err
+	}
+
+	unbondingPeriod, err := counterparty.unbondingPeriod(ctx)
+	if err != nil {
+		return err
+	}
+
+	clientState := ibctmtypes.NewClientState(
+		counterparty.ID,
+		ibctmtypes.DefaultTrustLevel,
+		unbondingPeriod*2/3,
+		unbondingPeriod,
+		maxClockDrift,
+		counterparty.height(height),
+		commitmenttypes.GetSDKSpecs(),
+		upgradePath,
+		false,
+		false,
+	)
+
+	msg, err := clienttypes.NewMsgCreateClient(clientState, header.ConsensusState(), e.address())
+	if err != nil {
+		return err
+	}
+
+	res, err := e.send(ctx, msg)
+	if err != nil {
+		return err
+	}
+
+	e.clientID, err = eventAttribute(res, clienttypes.EventTypeCreateClient, clienttypes.AttributeKeyClientID)
+	return err
+}
+
+// updateClient returns the msgs to update the client of e to the latest height of counterparty
+// and the height that the client is updated to. no msgs are returned when the client is
+// already up to date.
+func (e *end) updateClient(ctx context.Context, counterparty Chain) (msgs []sdk.Msg, height int64, err error) {
+	if height, err = counterparty.latestHeight(ctx); err != nil {
+		return nil, 0, err
+	}
+
+	clientState, err := e.clientState(ctx, e.clientID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	trustedHeight := clientState.GetLatestHeight()
+	if latest := int64(trustedHeight.GetRevisionHeight()); latest >= height {
+		return nil, latest, nil
+	}
+
+	header, err := counterparty.updateHeader(ctx, trustedHeight, height)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	msg, err := clienttypes.NewMsgUpdateClient(e.clientID, header, e.address())
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return []sdk.Msg{msg}, height, nil
+}
+
+// sendWithUpdate sends the msgs created by build in a tx that first updates the client of e.
+// build receives the height of counterparty that the client is updated to, so the proofs
+// in msgs can be made for that height.
+func (e *end) sendWithUpdate(ctx context.Context, counterparty Chain, build func(height int64) ([]sdk.Msg, error)) (
+	*sdk.TxResponse, error) {
+	updates, height, err := e.updateClient(ctx, counterparty)
+	if err != nil {
+		return nil, err
+	}
+
+	msgs, err := build(height)
+	if err != nil {
+		return nil, err
+	}
+
+	return e.send(ctx, append(updates, msgs...)...)
+}
+
+// connectionProofs are the proofs of the connection and client states of an end used in
+// a connection handshake.
+type connectionProofs struct {
+	clientState     exported.ClientState
+	connection      []byte
+	client          []byte
+	consensus       []byte
+	height          clienttypes.Height
+	consensusHeight clienttypes.Height
+}
+
+// proveConnection returns the proofs of the connection and client of e at height.
+func (e *end) proveConnection(ctx context.Context, height int64) (connectionProofs, error) {
+	var p connectionProofs
+
+	_, proof, proofHeight, err := e.query(ctx, host.ConnectionKey(e.connectionID), height)
+	if err != nil {
+		return connectionProofs{}, err
+	}
+	p.connection, p.height = proof, proofHeight
+
+	clientState, proof, _, err := e.query(ctx, host.FullClientStateKey(e.clientID), height)
+	if err != nil {
+		return connectionProofs{}, err
+	}
+	p.client = proof
+
+	if p.clientState, err = clienttypes.UnmarshalClientState(e.Client.Context.Codec, clientState); err != nil {
+		return connectionProofs{}, err
+	}
+	latest := p.clientState.GetLatestHeight()
+	p.consensusHeight = clienttypes.NewHeight(latest.GetRevisionNumber(), latest.GetRevisionHeight())
+
+	if _, p.consensus, _, err = e.query(ctx, host.FullConsensusStateKey(e.clientID, latest), height); err != nil {
+		return connectionProofs{}, err
+	}
+
+	return p, nil
+}
+
+// parseOrder parses a channel ordering, unordered is used when ordering is empty.
+func parseOrder(ordering string) (channeltypes.Order, error) {
+	if ordering == "" {
+		return channeltypes.UNORDERED, nil
+	}
+	order, ok := channeltypes.Order_value[ordering]
+	if !ok || order == int32(channeltypes.NONE) {
+		return channeltypes.NONE, fmt.Errorf("invalid channel ordering %q", ordering)
+	}
+	return channeltypes.Order(order), nil
+}
@@ -0,0 +1,318 @@
+package gorelayer
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/modules/core/24-host"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// txsPerPage is the number of txs fetched per tx search.
+const txsPerPage = 100
+
+// packet is a packet with its acknowledgement, if any.
+type packet struct {
+	channeltypes.Packet
+	ack []byte
+
+	// fee is the fee paid by the tx that emitted the packet event.
+	fee sdk.Coins
+}
+
+// relayPackets relays the packets sent from the from end since fromHeight to the to end
+// and times out the packets that can no longer be received. it returns the height to relay
+// from for the next time and the number of relayed packets.
+func (l link) relayPackets(ctx context.Context, from, to *end, fromHeight int64) (next int64, relayed int, err error) {
+	latest, err := from.latestHeight(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	// packets committed in the latest block cannot be proven yet.
+	if fromHeight >= latest {
+		return fromHeight, 0, nil
+	}
+
+	packets, err := from.searchPackets(ctx, channeltypes.EventTypeSendPacket, fromHeight, latest,
+		channeltypes.AttributeKeySrcPort, from.portID,
+		channeltypes.AttributeKeySrcChannel, from.channelID,
+	)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if packets = l.filter.apply(packets); len(packets) == 0 {
+		return latest, 0, nil
+	}
+
+	res, err := channeltypes.NewQueryClient(to.Client.Context).UnreceivedPackets(ctx, &channeltypes.QueryUnreceivedPacketsRequest{
+		PortId:                    to.portID,
+		ChannelId:                 to.channelID,
+		PacketCommitmentSequences: sequences(packets),
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+
+	status, err := to.Client.RPC.Status(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+	toHeight, toTime := to.height(status.SyncInfo.LatestBlockHeight), status.SyncInfo.LatestBlockTime
+
+	var recvs, timeouts []packet
+	for _, p := range filterSequences(packets, res.Sequences) {
+		if isTimedOut(p.Packet, toHeight, toTime) {
+			timeouts = append(timeouts, p)
+		} else {
+			recvs = append(recvs, p)
+		}
+	}
+
+	if len(recvs) > 0 {
+		if _, err := to.sendWithUpdate(ctx, from.Chain, func(height int64) ([]sdk.Msg, error) {
+			var msgs []sdk.Msg
+			for _, p := range recvs {
+				key := host.PacketCommitmentKey(p.SourcePort, p.SourceChannel, p.Sequence)
+				_, proof, proofHeight, err := from.query(ctx, key, height)
+				if err != nil {
+					return nil, err
+				}
+				msgs = append(msgs, channeltypes.NewMsgRecvPacket(p.Packet, proof, proofHeight, to.address()))
+			}
+			return msgs, nil
+		}); err != nil {
+			return 0, 0, err
+		}
+	}
+
+	if len(timeouts) > 0 {
+		if _, err := from.sendWithUpdate(ctx, to.Chain, func(height int64) ([]sdk.Msg, error) {
+			var msgs []sdk.Msg
+			for _, p := range timeouts {
+				// receipts are proven to be absent for unordered channels while the next sequence
+				// to receive is proven for ordered channels.
+				key := host.PacketReceiptKey(p.DestinationPort, p.DestinationChannel, p.Sequence)
+				if l.order == channeltypes.ORDERED {
+					key = host.NextSequenceRecvKey(p.DestinationPort, p.DestinationChannel)
+				}
+				value, proof, proofHeight, err := to.query(ctx, key, height)
+				if err != nil {
+					return nil, err
+				}
+				nextSequenceRecv := p.Sequence
+				if l.order == channeltypes.ORDERED {
+					nextSequenceRecv = sdk.BigEndianToUint64(value)
+				}
+				msgs = append(msgs, channeltypes.NewMsgTimeout(p.Packet, nextSequenceRecv, proof, proofHeight, from.address()))
+			}
+			return msgs, nil
+		}); err != nil {
+			return 0, 0, err
+		}
+	}
+
+	return latest, len(recvs), nil
+}
+
+// relayAcks relays the acknowledgements written by the from end since fromHeight to the to end,
+// that is the sender of the acknowledged packets. it returns the height to relay from for the next time
+// and the number of relayed acknowledgements.
+func (l link) relayAcks(ctx context.Context, from, to *end, fromHeight int64) (next int64, relayed int, err error) {
+	latest, err := from.latestHeight(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if fromHeight >= latest {
+		return fromHeight, 0, nil
+	}
+
+	packets, err := from.searchPackets(ctx, channeltypes.EventTypeWriteAck, fromHeight, latest,
+		channeltypes.AttributeKeyDstPort, from.portID,
+		channeltypes.AttributeKeyDstChannel, from.channelID,
+	)
+	if err != nil || len(packets) == 0 {
+		return latest, 0, err
+	}
+
+	res, err := channeltypes.NewQueryClient(to.Client.Context).UnreceivedAcks(ctx, &channeltypes.QueryUnreceivedAcksRequest{
+		PortId:             to.portID,
+		ChannelId:          to.channelID,
+		PacketAckSequences: sequences(packets),
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+
+	acks := filterSequences(packets, res.Sequences)
+	if len(acks) == 0 {
+		return latest, 0, nil
+	}
+
+	if _, err := to.sendWithUpdate(ctx, from.Chain, func(height int64) ([]sdk.Msg, error) {
+		var msgs []sdk.Msg
+		for _, p := range acks {
+			key := host.PacketAcknowledgementKey(p.DestinationPort, p.DestinationChannel, p.Sequence)
+			_, proof, proofHeight, err := from.query(ctx, key, height)
+			if err != nil {
+				return nil, err
+			}
+			msgs = append(msgs, channeltypes.NewMsgAcknowledgement(p.Packet, p.ack, proof, proofHeight, to.address()))
+		}
+		return msgs, nil
+	}); err != nil {
+		return 0, 0, err
+	}
+
+	return latest, len(acks), nil
+}
+
+// searchPackets returns the packets in the events of eventType emitted in [fromHeight, toHeight)
+// that have the given port and channel attributes, ordered by sequence.
+func (c Chain) searchPackets(
+	ctx context.Context,
+	eventType string,
+	fromHeight, toHeight int64,
+	portKey, portID, channelKey, channelID string,
+) ([]packet, error) {
+	var (
+		query = fmt.Sprintf("%[1]s.%[2]s='%[3]s' AND %[1]s.%[4]s='%[5]s' AND tx.height>=%[6]d AND tx.height<%[7]d",
+			eventType, portKey, portID, channelKey, channelID, fromHeight, toHeight)
+		page    = 1
+		perPage = txsPerPage
+		packets []packet
+		seen    int
+	)
+
+	for {
+		res, err := c.Client.RPC.TxSearch(ctx, query, false, &page, &perPage, "asc")
+		if err != nil {
+			return nil, err
+		}
+
+		for _, tx := range res.Txs {
+			fee, err := txFee(tx.TxResult.Events)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, event := range tx.TxResult.Events {
+				if event.Type != eventType {
+					continue
+				}
+				p, err := parsePacket(event)
+				if err != nil {
+					return nil, err
+				}
+				p.fee = fee
+				// txs may contain packets of other channels.
+				if attribute(event, portKey) != portID || attribute(event, channelKey) != channelID {
+					continue
+				}
+				packets = append(packets, p)
+			}
+		}
+
+		seen += len(res.Txs)
+		if seen >= res.TotalCount || len(res.Txs) == 0 {
+			break
+		}
+		page++
+	}
+
+	sort.Slice(packets, func(i, j int) bool { return packets[i].Sequence < packets[j].Sequence })
+
+	return packets, nil
+}
+
+// parsePacket parses a packet from the attributes of a packet event.
+func parsePacket(event abci.Event) (packet, error) {
+	var p packet
+	var err error
+
+	if p.Data, err = hex.DecodeString(attribute(event, channeltypes.AttributeKeyDataHex)); err != nil {
+		return packet{}, err
+	}
+	if p.ack, err = hex.DecodeString(attribute(event, channeltypes.AttributeKeyAckHex)); err != nil {
+		return packet{}, err
+	}
+	if p.Sequence, err = strconv.ParseUint(attribute(event, channeltypes.AttributeKeySequence), 10, 64); err != nil {
+		return packet{}, err
+	}
+	if p.TimeoutHeight, err = clienttypes.ParseHeight(attribute(event, channeltypes.AttributeKeyTimeoutHeight)); err != nil {
+		return packet{}, err
+	}
+	if p.TimeoutTimestamp, err = strconv.ParseUint(attribute(event, channeltypes.AttributeKeyTimeoutTimestamp), 10, 64); err != nil {
+		return packet{}, err
+	}
+
+	p.SourcePort = attribute(event, channeltypes.AttributeKeySrcPort)
+	p.SourceChannel = attribute(event, channeltypes.AttributeKeySrcChannel)
+	p.DestinationPort = attribute(event, channeltypes.AttributeKeyDstPort)
+	p.DestinationChannel = attribute(event, channeltypes.AttributeKeyDstChannel)
+
+	return p, nil
+}
+
+// txFee returns the fee paid by a tx from its events.
+func txFee(events []abci.Event) (sdk.Coins, error) {
+	for _, event := range events {
+		if event.Type != sdk.EventTypeTx {
+			continue
+		}
+		if fee := attribute(event, sdk.AttributeKeyFee); fee != "" {
+			return sdk.ParseCoinsNormalized(fee)
+		}
+	}
+	return sdk.Coins{}, nil
+}
+
+// attribute returns the value of the attribute with key in event.
+func attribute(event abci.Event, key string) string {
+	for _, attr := range event.Attributes {
+		if string(attr.Key) == key {
+			return string(attr.Value)
+		}
+	}
+	return ""
+}
+
+// isTimedOut checks if packet can no longer be received by a chain at height and time.
+func isTimedOut(packet channeltypes.Packet, height clienttypes.Height, t time.Time) bool {
+	return (!packet.TimeoutHeight.IsZero() && height.GTE(packet.TimeoutHeight)) ||
+		(packet.TimeoutTimestamp != 0 && uint64(t.UnixNano()) >= packet.TimeoutTimestamp)
+}
+
+// sequences returns the sequences of packets.
+func sequences(packets []packet) []uint64 {
+	seqs := make([]uint64, len(packets))
+	for i, p := range packets {
+		seqs[i] = p.Sequence
+	}
+	return seqs
+}
+
+// filterSequences returns the packets that have one of seqs.
+func filterSequences(packets []packet, seqs []uint64) []packet {
+	keep := make(map[uint64]bool, len(seqs))
+	for _, seq := range seqs {
+		keep[seq] = true
+	}
+
+	var filtered []packet
+	for _, p := range packets {
+		if keep[p.Sequence] {
+			filtered = append(filtered, p)
+		}
+	}
+	return filtered
+}
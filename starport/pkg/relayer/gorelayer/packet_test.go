@@ -0,0 +1,54 @@
+package gorelayer
+
+import (
+	"testing"
+	"time"
+
+	clienttypes "github.com/cosmos/ibc-go/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func TestParsePacket(t *testing.T) {
+	attrs := map[string]string{
+		channeltypes.AttributeKeyDataHex:          "7b7d",
+		channeltypes.AttributeKeyAckHex:           "7b22726573756c74223a2241513d3d227d",
+		channeltypes.AttributeKeyTimeoutHeight:    "1-100",
+		channeltypes.AttributeKeyTimeoutTimestamp: "0",
+		channeltypes.AttributeKeySequence:         "3",
+		channeltypes.AttributeKeySrcPort:          "transfer",
+		channeltypes.AttributeKeySrcChannel:       "channel-0",
+		channeltypes.AttributeKeyDstPort:          "transfer",
+		channeltypes.AttributeKeyDstChannel:       "channel-1",
+	}
+
+	event := abci.Event{Type: channeltypes.EventTypeWriteAck}
+	for key, value := range attrs {
+		event.Attributes = append(event.Attributes, abci.EventAttribute{Key: []byte(key), Value: []byte(value)})
+	}
+
+	p, err := parsePacket(event)
+	require.NoError(t, err)
+	require.Equal(t, channeltypes.NewPacket(
+		[]byte("{}"), 3, "transfer", "channel-0", "transfer", "channel-1", clienttypes.NewHeight(1, 100), 0,
+	), p.Packet)
+	require.Equal(t, `{"result":"AQ=="}`, string(p.ack))
+
+	event.Attributes = event.Attributes[:0]
+	_, err = parsePacket(event)
+	require.Error(t, err)
+}
+
+func TestIsTimedOut(t *testing.T) {
+	now := time.Now()
+	packet := channeltypes.Packet{TimeoutHeight: clienttypes.NewHeight(1, 100)}
+
+	require.False(t, isTimedOut(packet, clienttypes.NewHeight(1, 99), now))
+	require.True(t, isTimedOut(packet, clienttypes.NewHeight(1, 100), now))
+
+	packet = channeltypes.Packet{TimeoutTimestamp: uint64(now.UnixNano())}
+
+	require.False(t, isTimedOut(packet, clienttypes.NewHeight(1, 100), now.Add(-time.Second)))
+	require.True(t, isTimedOut(packet, clienttypes.NewHeight(1, 100), now))
+}
@@ -0,0 +1,109 @@
+package gorelayer
+
+import (
+	"context"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	channeltypes "github.com/cosmos/ibc-go/modules/core/04-channel/types"
+	relayerconf "github.com/tendermint/starport/starport/pkg/relayer/config"
+)
+
+// commitmentsPerPage is the number of packet commitments fetched per query.
+const commitmentsPerPage = 100
+
+// EndStatus is the relaying status of an end of a path.
+type EndStatus struct {
+	// PendingPackets is the number of packets sent from the end that are not received
+	// by the counterparty yet.
+	PendingPackets int
+
+	// PendingAcks is the number of packets sent from the end that are received by the
+	// counterparty but whose acknowledgements are not relayed back yet.
+	PendingAcks int
+
+	// ClientID is the id of the client on the end that tracks the counterparty chain.
+	ClientID string
+
+	// ClientExpiry is the time that the client expires unless it is updated.
+	ClientExpiry time.Time
+}
+
+// Status returns the relaying status of both ends of a linked path.
+// it doesn't depend on the relayer backend that relays the path.
+func Status(ctx context.Context, path relayerconf.Path, src, dst Chain) (srcStatus, dstStatus EndStatus, err error) {
+	l, err := loadLink(ctx, path, src, dst)
+	if err != nil {
+		return EndStatus{}, EndStatus{}, err
+	}
+
+	if srcStatus, err = l.status(ctx, l.src, l.dst); err != nil {
+		return EndStatus{}, EndStatus{}, err
+	}
+	if dstStatus, err = l.status(ctx, l.dst, l.src); err != nil {
+		return EndStatus{}, EndStatus{}, err
+	}
+
+	return srcStatus, dstStatus, nil
+}
+
+// status returns the status of the from end.
+func (l link) status(ctx context.Context, from, to *end) (EndStatus, error) {
+	status := EndStatus{ClientID: from.clientID}
+
+	// packets keep their commitments until they are acknowledged or timed out.
+	seqs, err := from.packetCommitments(ctx)
+	if err != nil {
+		return EndStatus{}, err
+	}
+
+	if len(seqs) > 0 {
+		res, err := channeltypes.NewQueryClient(to.Client.Context).UnreceivedPackets(ctx, &channeltypes.QueryUnreceivedPacketsRequest{
+			PortId:                    to.portID,
+			ChannelId:                 to.channelID,
+			PacketCommitmentSequences: seqs,
+		})
+		if err != nil {
+			return EndStatus{}, err
+		}
+
+		status.PendingPackets = len(res.Sequences)
+		status.PendingAcks = len(seqs) - len(res.Sequences)
+	}
+
+	client, err := from.clientStatus(ctx, to.Chain)
+	if err != nil {
+		return EndStatus{}, err
+	}
+	status.ClientExpiry = client.ExpiresAt()
+
+	return status, nil
+}
+
+// packetCommitments returns the sequences of the packets that have commitments on the end.
+func (e *end) packetCommitments(ctx context.Context) ([]uint64, error) {
+	var (
+		seqs []uint64
+		key  []byte
+	)
+
+	for {
+		res, err := channeltypes.NewQueryClient(e.Client.Context).PacketCommitments(ctx, &channeltypes.QueryPacketCommitmentsRequest{
+			PortId:     e.portID,
+			ChannelId:  e.channelID,
+			Pagination: &query.PageRequest{Key: key, Limit: commitmentsPerPage},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, commitment := range res.Commitments {
+			seqs = append(seqs, commitment.Sequence)
+		}
+
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return seqs, nil
+		}
+		key = res.Pagination.NextKey
+	}
+}
@@ -0,0 +1,139 @@
+package relayer
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	relayerconf "github.com/tendermint/starport/starport/pkg/relayer/config"
+)
+
+const metricsNamespace = "starport_relayer"
+
+// Metrics are the Prometheus metrics of a relayer.
+type Metrics struct {
+	registry *prometheus.Registry
+
+	packetsRelayed     *prometheus.GaugeVec
+	acksRelayed        *prometheus.GaugeVec
+	pendingPackets     *prometheus.GaugeVec
+	pendingAcks        *prometheus.GaugeVec
+	clientExpiry       *prometheus.GaugeVec
+	balance            *prometheus.GaugeVec
+	errors             *prometheus.CounterVec
+	lastErrorTimestamp *prometheus.GaugeVec
+	statusErrors       *prometheus.CounterVec
+}
+
+// NewMetrics creates relayer metrics.
+func NewMetrics() *Metrics {
+	pathLabels := []string{"path", "chain_id"}
+
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		packetsRelayed: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "packets_relayed",
+			Help:      "Number of packets sent from the chain that are relayed.",
+		}, pathLabels),
+		acksRelayed: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "acks_relayed",
+			Help:      "Number of acknowledgements relayed back to the chain.",
+		}, pathLabels),
+		pendingPackets: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "pending_packets",
+			Help:      "Number of packets sent from the chain that are not received by the counterparty.",
+		}, pathLabels),
+		pendingAcks: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "pending_acks",
+			Help:      "Number of packets sent from the chain whose acknowledgements are not relayed back.",
+		}, pathLabels),
+		clientExpiry: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "client_expiry_timestamp_seconds",
+			Help:      "Unix time that the client on the chain expires unless it is updated.",
+		}, append(pathLabels, "client_id")),
+		balance: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "account_balance",
+			Help:      "Balance of the relayer account on the chain.",
+		}, []string{"chain_id", "address", "denom"}),
+		errors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "errors_total",
+			Help:      "Number of errors occurred while relaying the path.",
+		}, []string{"path"}),
+		lastErrorTimestamp: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "last_error_timestamp_seconds",
+			Help:      "Unix time of the last error occurred while relaying the path.",
+		}, []string{"path"}),
+		statusErrors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "status_errors_total",
+			Help:      "Number of errors occurred while collecting the status of the path for the metrics.",
+		}, []string{"path"}),
+	}
+
+	m.registry.MustRegister(
+		m.packetsRelayed,
+		m.acksRelayed,
+		m.pendingPackets,
+		m.pendingAcks,
+		m.clientExpiry,
+		m.balance,
+		m.errors,
+		m.lastErrorTimestamp,
+		m.statusErrors,
+	)
+
+	return m
+}
+
+// Handler returns an http handler that serves the metrics in the Prometheus format.
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{})
+}
+
+// observe records the status of a path.
+func (m *Metrics) observe(status PathStatus) {
+	path := status.Path
+
+	for _, e := range []struct {
+		pathEnd relayerconf.PathEnd
+		status  EndStatus
+	}{
+		{path.Src, status.Src},
+		{path.Dst, status.Dst},
+	} {
+		chainID := e.pathEnd.ChainID
+
+		m.packetsRelayed.WithLabelValues(path.ID, chainID).Set(float64(e.pathEnd.PacketsRelayed))
+		m.acksRelayed.WithLabelValues(path.ID, chainID).Set(float64(e.pathEnd.AcksRelayed))
+		m.pendingPackets.WithLabelValues(path.ID, chainID).Set(float64(e.status.PendingPackets))
+		m.pendingAcks.WithLabelValues(path.ID, chainID).Set(float64(e.status.PendingAcks))
+
+		if !e.status.ClientExpiry.IsZero() {
+			m.clientExpiry.WithLabelValues(path.ID, chainID, e.status.ClientID).Set(float64(e.status.ClientExpiry.Unix()))
+		}
+
+		for _, coin := range e.status.Balances {
+			amount, _ := coin.Amount.ToDec().Float64()
+			m.balance.WithLabelValues(chainID, e.status.Address, coin.Denom).Set(amount)
+		}
+	}
+}
+
+// observeError records an error occurred while relaying a path.
+func (m *Metrics) observeError(path relayerconf.Path) {
+	m.errors.WithLabelValues(path.ID).Inc()
+	m.lastErrorTimestamp.WithLabelValues(path.ID).Set(float64(path.LastErrorAt.Unix()))
+}
+
+// observeStatusError records an error occurred while collecting the status of a path.
+func (m *Metrics) observeStatusError(path relayerconf.Path) {
+	m.statusErrors.WithLabelValues(path.ID).Inc()
+}
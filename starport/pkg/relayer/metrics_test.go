@@ -0,0 +1,54 @@
+package relayer
+
+import (
+	"io"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	relayerconf "github.com/tendermint/starport/starport/pkg/relayer/config"
+	"github.com/tendermint/starport/starport/pkg/relayer/gorelayer"
+)
+
+func TestMetrics(t *testing.T) {
+	m := NewMetrics()
+
+	path := relayerconf.Path{
+		ID:  "mars-venus",
+		Src: relayerconf.PathEnd{ChainID: "mars", PacketsRelayed: 3, AcksRelayed: 2},
+		Dst: relayerconf.PathEnd{ChainID: "venus"},
+	}
+
+	m.observe(PathStatus{
+		Path: path,
+		Src: EndStatus{
+			EndStatus: gorelayer.EndStatus{PendingPackets: 1, ClientID: "07-tendermint-0", ClientExpiry: time.Unix(100, 0)},
+			Address:   "cosmos1",
+			Balances:  sdk.NewCoins(sdk.NewInt64Coin("stake", 42)),
+		},
+	})
+
+	path.LastErrorAt = time.Unix(200, 0)
+	m.observeError(path)
+	m.observeStatusError(path)
+
+	rec := httptest.NewRecorder()
+	m.Handler().ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+	body, err := io.ReadAll(rec.Body)
+	require.NoError(t, err)
+
+	for _, metric := range []string{
+		`starport_relayer_packets_relayed{chain_id="mars",path="mars-venus"} 3`,
+		`starport_relayer_acks_relayed{chain_id="mars",path="mars-venus"} 2`,
+		`starport_relayer_pending_packets{chain_id="mars",path="mars-venus"} 1`,
+		`starport_relayer_client_expiry_timestamp_seconds{chain_id="mars",client_id="07-tendermint-0",path="mars-venus"} 100`,
+		`starport_relayer_account_balance{address="cosmos1",chain_id="mars",denom="stake"} 42`,
+		`starport_relayer_errors_total{path="mars-venus"} 1`,
+		`starport_relayer_last_error_timestamp_seconds{path="mars-venus"} 200`,
+		`starport_relayer_status_errors_total{path="mars-venus"} 1`,
+	} {
+		require.Contains(t, string(body), metric)
+	}
+}
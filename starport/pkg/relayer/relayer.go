@@ -2,18 +2,19 @@ package relayer
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 	"sync"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/tendermint/starport/starport/pkg/cosmosaccount"
 	"github.com/tendermint/starport/starport/pkg/cosmosclient"
 	"github.com/tendermint/starport/starport/pkg/ctxticker"
 	tsrelayer "github.com/tendermint/starport/starport/pkg/nodetime/programs/ts-relayer"
 	relayerconf "github.com/tendermint/starport/starport/pkg/relayer/config"
+	"github.com/tendermint/starport/starport/pkg/relayer/gorelayer"
 	"github.com/tendermint/starport/starport/pkg/xurl"
 	"golang.org/x/sync/errgroup"
 )
@@ -25,15 +26,49 @@ const (
 
 // Relayer is an IBC relayer.
 type Relayer struct {
-	ca cosmosaccount.Registry
+	ca      cosmosaccount.Registry
+	metrics *Metrics
+	signer  cosmosclient.Signer
+
+	onExpiredClient func(ClientStatus)
+}
+
+// RelayerOption configures Relayer.
+type RelayerOption func(*Relayer)
+
+// WithMetrics records the metrics of the relayed paths to m while relaying.
+func WithMetrics(m *Metrics) RelayerOption {
+	return func(r *Relayer) {
+		r.metrics = m
+	}
+}
+
+// WithSigner signs the transactions of the relayer accounts with signer.
+// signers are only supported by the go backend.
+func WithSigner(signer cosmosclient.Signer) RelayerOption {
+	return func(r *Relayer) {
+		r.signer = signer
+	}
+}
+
+// WithExpiredClientHandler calls handle for expired clients of the relayed paths while relaying.
+// handle is called again for the same client every hour until the client is recovered.
+func WithExpiredClientHandler(handle func(ClientStatus)) RelayerOption {
+	return func(r *Relayer) {
+		r.onExpiredClient = handle
+	}
 }
 
 // New creates a new IBC relayer and uses ca to access accounts.
-func New(ca cosmosaccount.Registry) Relayer {
+func New(ca cosmosaccount.Registry, options ...RelayerOption) Relayer {
 	r := Relayer{
 		ca: ca,
 	}
 
+	for _, apply := range options {
+		apply(&r)
+	}
+
 	return r
 }
 
@@ -72,45 +107,102 @@ func (r Relayer) Link(ctx context.Context, pathIDs ...string) error {
 }
 
 // Start relays packets for linked paths until ctx is canceled.
+// errors are saved to the paths before Start returns with them.
 func (r Relayer) Start(ctx context.Context, pathIDs ...string) error {
-	conf, err := relayerconf.Get()
-	if err != nil {
-		return err
-	}
-
 	wg, ctx := errgroup.WithContext(ctx)
-	var m sync.Mutex // protects relayerconf.Path.
+	var m sync.Mutex // protects relayerconf.Config.
+
+	// updatePath calls update with the latest version of the path and saves the updated path.
+	updatePath := func(id string, update func(relayerconf.Path) relayerconf.Path) error {
+		m.Lock()
+		defer m.Unlock()
+
+		conf, err := relayerconf.Get()
+		if err != nil {
+			return err
+		}
 
-	start := func(id string) error {
 		path, err := conf.PathByID(id)
 		if err != nil {
 			return err
 		}
 
-		if path, err = r.call(ctx, conf, path, "start"); err != nil {
+		if err := conf.UpdatePath(update(path)); err != nil {
 			return err
 		}
 
-		m.Lock()
-		defer m.Unlock()
+		return relayerconf.Save(conf)
+	}
 
+	// refreshAt is when to refresh the clients of a path next time.
+	start := func(id string, refreshAt *time.Time) error {
+		m.Lock()
 		conf, err := relayerconf.Get()
+		m.Unlock()
 		if err != nil {
 			return err
 		}
 
-		if err := conf.UpdatePath(path); err != nil {
+		path, err := conf.PathByID(id)
+		if err != nil {
 			return err
 		}
 
-		return relayerconf.Save(conf)
+		if path, err = r.call(ctx, conf, path, "start"); err != nil {
+			return err
+		}
+
+		if err := updatePath(id, func(relayerconf.Path) relayerconf.Path { return path }); err != nil {
+			return err
+		}
+
+		// clients are refreshed independently from the relayer backend since they are
+		// only updated when there are packets to relay.
+		if path.Src.ChannelID != "" && !time.Now().Before(*refreshAt) {
+			if *refreshAt, err = r.refreshClients(ctx, conf, path); err != nil {
+				return err
+			}
+		}
+
+		// failing to collect the status for the metrics doesn't stop relaying the path.
+		if r.metrics != nil {
+			status, err := r.pathStatus(ctx, conf, path)
+			if err != nil {
+				r.metrics.observeStatusError(path)
+			} else {
+				r.metrics.observe(status)
+			}
+		}
+
+		return nil
 	}
 
 	for _, id := range pathIDs {
 		id := id
 
 		wg.Go(func() error {
-			return ctxticker.DoNow(ctx, relayDuration, func() error { return start(id) })
+			var refreshAt time.Time
+
+			err := ctxticker.DoNow(ctx, relayDuration, func() error { return start(id, &refreshAt) })
+			if err == nil || errors.Is(err, context.Canceled) {
+				return err
+			}
+
+			// save the error to let users to find out why relaying stopped.
+			if serr := updatePath(id, func(path relayerconf.Path) relayerconf.Path {
+				path.LastError = err.Error()
+				path.LastErrorAt = time.Now()
+
+				if r.metrics != nil {
+					r.metrics.observeError(path)
+				}
+
+				return path
+			}); serr != nil {
+				return serr
+			}
+
+			return err
 		})
 	}
 
@@ -119,49 +211,141 @@ func (r Relayer) Start(ctx context.Context, pathIDs ...string) error {
 
 func (r Relayer) call(ctx context.Context, conf relayerconf.Config, path relayerconf.Path, action string) (
 	relayerconf.Path, error) {
-	srcChain, srcKey, err := r.prepare(ctx, conf, path.Src.ChainID)
+	srcChain, err := r.prepare(ctx, conf, path.Src.ChainID)
 	if err != nil {
 		return relayerconf.Path{}, err
 	}
 
-	dstChain, dstKey, err := r.prepare(ctx, conf, path.Dst.ChainID)
+	dstChain, err := r.prepare(ctx, conf, path.Dst.ChainID)
+	if err != nil {
+		return relayerconf.Path{}, err
+	}
+
+	switch conf.Backend {
+	case "", relayerconf.BackendTSRelayer:
+		return r.callTSRelayer(ctx, path, srcChain, dstChain, action)
+	case relayerconf.BackendGo:
+		return r.callGo(ctx, path, srcChain, dstChain, action)
+	default:
+		return relayerconf.Path{}, fmt.Errorf("unknown relayer backend %q", conf.Backend)
+	}
+}
+
+// callGo calls action with the native Go relayer.
+func (r Relayer) callGo(ctx context.Context, path relayerconf.Path, srcChain, dstChain relayerconf.Chain, action string) (
+	relayerconf.Path, error) {
+	src, dst, err := r.goChains(ctx, srcChain, dstChain)
+	if err != nil {
+		return relayerconf.Path{}, err
+	}
+
+	switch action {
+	case "link":
+		return gorelayer.Link(ctx, path, src, dst)
+	case "start":
+		return gorelayer.Relay(ctx, path, src, dst)
+	default:
+		return relayerconf.Path{}, fmt.Errorf("unknown relayer action %q", action)
+	}
+}
+
+// callTSRelayer calls action with the TypeScript relayer.
+func (r Relayer) callTSRelayer(ctx context.Context, path relayerconf.Path, srcChain, dstChain relayerconf.Chain, action string) (
+	relayerconf.Path, error) {
+	if !path.Filter.IsEmpty() {
+		return relayerconf.Path{}, fmt.Errorf("path %q has a packet filter that is not supported by the %s backend",
+			path.ID, relayerconf.BackendTSRelayer)
+	}
+	if r.signer != nil {
+		return relayerconf.Path{}, fmt.Errorf("remote signers are not supported by the %s backend", relayerconf.BackendTSRelayer)
+	}
+
+	srcKey, err := r.ca.ExportHex(srcChain.Account, "")
+	if err != nil {
+		return relayerconf.Path{}, err
+	}
+
+	dstKey, err := r.ca.ExportHex(dstChain.Account, "")
 	if err != nil {
 		return relayerconf.Path{}, err
 	}
 
 	var reply relayerconf.Path
 
-	err = tsrelayer.Call(ctx, action, []interface{}{
+	if err := tsrelayer.Call(ctx, action, []interface{}{
 		path,
 		srcChain,
 		dstChain,
 		srcKey,
 		dstKey,
-	}, &reply)
+	}, &reply); err != nil {
+		return relayerconf.Path{}, err
+	}
+
+	// the ts relayer doesn't keep the fields that it doesn't know about.
+	reply.LastError, reply.LastErrorAt = path.LastError, path.LastErrorAt
+	reply.Src.PacketsRelayed, reply.Src.AcksRelayed = path.Src.PacketsRelayed, path.Src.AcksRelayed
+	reply.Dst.PacketsRelayed, reply.Dst.AcksRelayed = path.Dst.PacketsRelayed, path.Dst.AcksRelayed
 
-	return reply, err
+	return reply, nil
 }
 
-func (r Relayer) prepare(ctx context.Context, conf relayerconf.Config, chainID string) (
-	chain relayerconf.Chain, privKey string, err error) {
-	chain, err = conf.ChainByID(chainID)
+// goChain creates a chain for the native Go relayer.
+func (r Relayer) goChain(ctx context.Context, chain relayerconf.Chain) (gorelayer.Chain, error) {
+	options := []cosmosclient.Option{
+		cosmosclient.WithNodeAddress(chain.RPCAddress),
+		cosmosclient.WithAddressPrefix(chain.AddressPrefix),
+		cosmosclient.WithGasPrices(chain.GasPrice),
+		cosmosclient.WithAccountRegistry(r.ca),
+	}
+	if r.signer != nil {
+		options = append(options, cosmosclient.WithSigner(r.signer))
+	}
+
+	client, err := cosmosclient.New(ctx, options...)
+	if err != nil {
+		return gorelayer.Chain{}, err
+	}
+
+	account, err := r.ca.GetByName(chain.Account)
+	if err != nil {
+		return gorelayer.Chain{}, err
+	}
+
+	return gorelayer.NewChain(client, account, chain.AddressPrefix), nil
+}
+
+// goChains creates the chains of a path for the native Go relayer.
+func (r Relayer) goChains(ctx context.Context, srcChain, dstChain relayerconf.Chain) (src, dst gorelayer.Chain, err error) {
+	if src, err = r.goChain(ctx, srcChain); err != nil {
+		return gorelayer.Chain{}, gorelayer.Chain{}, err
+	}
+	if dst, err = r.goChain(ctx, dstChain); err != nil {
+		return gorelayer.Chain{}, gorelayer.Chain{}, err
+	}
+	return src, dst, nil
+}
+
+// prepare returns the chain with chainID and makes sure that its relayer account has enough balances.
+func (r Relayer) prepare(ctx context.Context, conf relayerconf.Config, chainID string) (relayerconf.Chain, error) {
+	chain, err := conf.ChainByID(chainID)
 	if err != nil {
-		return relayerconf.Chain{}, "", err
+		return relayerconf.Chain{}, err
 	}
 
 	coins, err := r.balance(ctx, chain.RPCAddress, chain.Account, chain.AddressPrefix)
 	if err != nil {
-		return relayerconf.Chain{}, "", err
+		return relayerconf.Chain{}, err
 	}
 
 	gasPrice, err := sdk.ParseCoinNormalized(chain.GasPrice)
 	if err != nil {
-		return relayerconf.Chain{}, "", err
+		return relayerconf.Chain{}, err
 	}
 
 	account, err := r.ca.GetByName(chain.Account)
 	if err != nil {
-		return relayerconf.Chain{}, "", err
+		return relayerconf.Chain{}, err
 	}
 
 	errMissingBalance := fmt.Errorf(`account "%s(%s)" on %q chain does not have enough balances`,
@@ -171,7 +355,7 @@ func (r Relayer) prepare(ctx context.Context, conf relayerconf.Config, chainID s
 	)
 
 	if len(coins) == 0 {
-		return relayerconf.Chain{}, "", errMissingBalance
+		return relayerconf.Chain{}, errMissingBalance
 	}
 
 	for _, coin := range coins {
@@ -180,20 +364,18 @@ func (r Relayer) prepare(ctx context.Context, conf relayerconf.Config, chainID s
 		}
 
 		if gasPrice.Amount.Int64()*ibcSetupGas > coin.Amount.Int64() {
-			return relayerconf.Chain{}, "", errMissingBalance
+			return relayerconf.Chain{}, errMissingBalance
 		}
 	}
 
-	key, err := r.ca.ExportHex(chain.Account, "")
-	if err != nil {
-		return relayerconf.Chain{}, "", err
-	}
-
-	return chain, key, nil
+	return chain, nil
 }
 
 func (r Relayer) balance(ctx context.Context, rpcAddress, account, addressPrefix string) (sdk.Coins, error) {
-	client, err := cosmosclient.New(ctx, cosmosclient.WithNodeAddress(rpcAddress))
+	client, err := cosmosclient.New(ctx,
+		cosmosclient.WithNodeAddress(rpcAddress),
+		cosmosclient.WithAccountRegistry(r.ca),
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -203,15 +385,23 @@ func (r Relayer) balance(ctx context.Context, rpcAddress, account, addressPrefix
 		return nil, err
 	}
 
-	addr := acc.Address(addressPrefix)
+	return client.BankBalances(ctx, acc.Address(addressPrefix))
+}
 
-	queryClient := banktypes.NewQueryClient(client.Context)
-	res, err := queryClient.AllBalances(ctx, &banktypes.QueryAllBalancesRequest{Address: addr})
+// SetBackend sets the backend used to link chains and relay packets.
+func (r Relayer) SetBackend(backend string) error {
+	if backend != relayerconf.BackendGo && backend != relayerconf.BackendTSRelayer {
+		return fmt.Errorf("unknown relayer backend %q", backend)
+	}
+
+	conf, err := relayerconf.Get()
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	return res.Balances, nil
+	conf.Backend = backend
+
+	return relayerconf.Save(conf)
 }
 
 // GetPath returns a path by its id.
@@ -0,0 +1,121 @@
+package relayer
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	relayerconf "github.com/tendermint/starport/starport/pkg/relayer/config"
+	"github.com/tendermint/starport/starport/pkg/relayer/gorelayer"
+)
+
+// PathStatus is the status of a path.
+type PathStatus struct {
+	Path relayerconf.Path
+	Src  EndStatus
+	Dst  EndStatus
+}
+
+// EndStatus is the status of an end of a path.
+// the relaying status is only filled for linked paths.
+type EndStatus struct {
+	gorelayer.EndStatus
+
+	// Address is the address of the relayer account on the chain.
+	Address string
+
+	// Balances are the balances of the relayer account on the chain.
+	Balances sdk.Coins
+}
+
+// Status returns the status of paths. the status of all paths are returned when no path ids are given.
+func (r Relayer) Status(ctx context.Context, pathIDs ...string) ([]PathStatus, error) {
+	conf, err := relayerconf.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	paths, err := pathsByID(conf, pathIDs...)
+	if err != nil {
+		return nil, err
+	}
+
+	var statuses []PathStatus
+
+	for _, path := range paths {
+		status, err := r.pathStatus(ctx, conf, path)
+		if err != nil {
+			return nil, err
+		}
+		statuses = append(statuses, status)
+	}
+
+	return statuses, nil
+}
+
+// pathStatus returns the status of path.
+func (r Relayer) pathStatus(ctx context.Context, conf relayerconf.Config, path relayerconf.Path) (PathStatus, error) {
+	status := PathStatus{Path: path}
+
+	srcChain, dstChain, err := pathChains(conf, path)
+	if err != nil {
+		return PathStatus{}, err
+	}
+
+	src, dst, err := r.goChains(ctx, srcChain, dstChain)
+	if err != nil {
+		return PathStatus{}, err
+	}
+
+	for _, e := range []struct {
+		chain  gorelayer.Chain
+		status *EndStatus
+	}{
+		{src, &status.Src},
+		{dst, &status.Dst},
+	} {
+		e.status.Address = e.chain.Account.Address(e.chain.AddressPrefix)
+		if e.status.Balances, err = e.chain.Client.BankBalances(ctx, e.status.Address); err != nil {
+			return PathStatus{}, err
+		}
+	}
+
+	if path.Src.ChannelID == "" { // not linked yet.
+		return status, nil
+	}
+
+	status.Src.EndStatus, status.Dst.EndStatus, err = gorelayer.Status(ctx, path, src, dst)
+	if err != nil {
+		return PathStatus{}, err
+	}
+
+	return status, nil
+}
+
+// pathsByID returns the paths with ids from conf. all paths are returned when no ids are given.
+func pathsByID(conf relayerconf.Config, ids ...string) ([]relayerconf.Path, error) {
+	if len(ids) == 0 {
+		return conf.Paths, nil
+	}
+
+	var paths []relayerconf.Path
+	for _, id := range ids {
+		path, err := conf.PathByID(id)
+		if err != nil {
+			return nil, err
+		}
+		paths = append(paths, path)
+	}
+
+	return paths, nil
+}
+
+// pathChains returns the chains of path's ends from conf.
+func pathChains(conf relayerconf.Config, path relayerconf.Path) (src, dst relayerconf.Chain, err error) {
+	if src, err = conf.ChainByID(path.Src.ChainID); err != nil {
+		return relayerconf.Chain{}, relayerconf.Chain{}, err
+	}
+	if dst, err = conf.ChainByID(path.Dst.ChainID); err != nil {
+		return relayerconf.Chain{}, relayerconf.Chain{}, err
+	}
+	return src, dst, nil
+}
@@ -5,6 +5,7 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/go-git/go-git/v5"
 	"github.com/gookit/color"
@@ -13,6 +14,7 @@ import (
 	"github.com/tendermint/starport/starport/pkg/chaincmd"
 	chaincmdrunner "github.com/tendermint/starport/starport/pkg/chaincmd/runner"
 	"github.com/tendermint/starport/starport/pkg/confile"
+	"github.com/tendermint/starport/starport/pkg/cosmosaccount"
 	"github.com/tendermint/starport/starport/pkg/cosmosver"
 	"github.com/tendermint/starport/starport/pkg/repoversion"
 	"github.com/tendermint/starport/starport/pkg/xurl"
@@ -77,12 +79,18 @@ type chainOptions struct {
 	// keyring backend used by commands if not specified in configuration
 	keyringBackend chaincmd.KeyringBackend
 
+	// keyringPassphrase unlocks the keyring when the file keyring backend is used.
+	keyringPassphrase string
+
 	// isThirdPartyModuleCodegen indicates if proto code generation should be made
 	// for 3rd party modules. SDK modules are also considered as a 3rd party.
 	isThirdPartyModuleCodegenEnabled bool
 
 	// path of a custom config file
 	ConfigFile string
+
+	// configOverrides modify the config after it is loaded.
+	configOverrides []func(*chainconfig.Config)
 }
 
 // Option configures Chain.
@@ -116,6 +124,13 @@ func KeyringBackend(keyringBackend chaincmd.KeyringBackend) Option {
 	}
 }
 
+// KeyringPassphrase sets the passphrase to unlock the keyring when the file keyring backend is used.
+func KeyringPassphrase(passphrase string) Option {
+	return func(c *Chain) {
+		c.options.keyringPassphrase = passphrase
+	}
+}
+
 // ConfigFile specifies a custom config file to use
 func ConfigFile(configFile string) Option {
 	return func(c *Chain) {
@@ -123,6 +138,14 @@ func ConfigFile(configFile string) Option {
 	}
 }
 
+// ConfigOverride modifies the config with override every time after it is loaded
+// without changing the config file.
+func ConfigOverride(override func(*chainconfig.Config)) Option {
+	return func(c *Chain) {
+		c.options.configOverrides = append(c.options.configOverrides, override)
+	}
+}
+
 // EnableThirdPartyModuleCodegen enables code generation for third party modules,
 // including the SDK.
 func EnableThirdPartyModuleCodegen() Option {
@@ -218,11 +241,20 @@ func (c *Chain) ConfigPath() string {
 
 // Config returns the config of the chain
 func (c *Chain) Config() (chainconfig.Config, error) {
-	configPath := c.ConfigPath()
-	if configPath == "" {
-		return chainconfig.DefaultConf, nil
+	conf := chainconfig.DefaultConf
+
+	if configPath := c.ConfigPath(); configPath != "" {
+		var err error
+		if conf, err = chainconfig.ParseFile(configPath); err != nil {
+			return chainconfig.Config{}, err
+		}
+	}
+
+	for _, override := range c.options.configOverrides {
+		override(&conf)
 	}
-	return chainconfig.ParseFile(configPath)
+
+	return conf, nil
 }
 
 // ID returns the chain's id.
@@ -330,6 +362,12 @@ func (c *Chain) ClientTOMLPath() (string, error) {
 	return filepath.Join(home, "config/client.toml"), nil
 }
 
+// KeyringServiceName returns the name of the keyring service used by the chain's binary.
+// it matches the version.Name set when the binary is built.
+func (c *Chain) KeyringServiceName() string {
+	return strings.Title(c.app.Name)
+}
+
 // KeyringBackend returns the keyring backend chosen for the chain.
 func (c *Chain) KeyringBackend() (chaincmd.KeyringBackend, error) {
 	// 1st.
@@ -376,6 +414,26 @@ func (c *Chain) KeyringBackend() (chaincmd.KeyringBackend, error) {
 	return chaincmd.KeyringBackendTest, nil
 }
 
+// AccountRegistry returns the registry of the accounts in the chain's keyring.
+func (c *Chain) AccountRegistry() (cosmosaccount.Registry, error) {
+	home, err := c.Home()
+	if err != nil {
+		return cosmosaccount.Registry{}, err
+	}
+
+	backend, err := c.KeyringBackend()
+	if err != nil {
+		return cosmosaccount.Registry{}, err
+	}
+
+	return cosmosaccount.New(
+		cosmosaccount.WithHome(home),
+		cosmosaccount.WithKeyringServiceName(c.KeyringServiceName()),
+		cosmosaccount.WithKeyringBackend(cosmosaccount.KeyringBackend(backend)),
+		cosmosaccount.WithKeyringPassphrase(c.options.keyringPassphrase),
+	)
+}
+
 // Commands returns the runner execute commands on the chain's binary
 func (c *Chain) Commands(ctx context.Context) (chaincmdrunner.Runner, error) {
 	id, err := c.ID()
@@ -410,6 +468,9 @@ func (c *Chain) Commands(ctx context.Context) (chaincmdrunner.Runner, error) {
 		chaincmd.WithNodeAddress(xurl.TCP(config.Host.RPC)),
 		chaincmd.WithKeyringBackend(backend),
 	}
+	if c.options.keyringPassphrase != "" {
+		chainCommandOptions = append(chainCommandOptions, chaincmd.WithKeyringPassword(c.options.keyringPassphrase))
+	}
 
 	cc := chaincmd.New(binary, chainCommandOptions...)
 
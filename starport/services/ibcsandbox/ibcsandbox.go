@@ -0,0 +1,390 @@
+// Package ibcsandbox serves two chains locally and relays IBC packets in between to
+// test IBC modules in development.
+package ibcsandbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/tendermint/starport/starport/chainconfig"
+	"github.com/tendermint/starport/starport/pkg/cosmosaccount"
+	"github.com/tendermint/starport/starport/pkg/cosmosclient"
+	"github.com/tendermint/starport/starport/pkg/cosmoscoin"
+	"github.com/tendermint/starport/starport/pkg/relayer"
+	relayerconf "github.com/tendermint/starport/starport/pkg/relayer/config"
+	"github.com/tendermint/starport/starport/pkg/xurl"
+	"github.com/tendermint/starport/starport/services/chain"
+	rpchttp "github.com/tendermint/tendermint/rpc/client/http"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	// relayerFunds is the amount of the staking denom sent to the relayer accounts.
+	relayerFunds = 10000000
+
+	// retryDelay is the time to wait before relaying again after it fails e.g. when a chain
+	// is restarted because of source changes.
+	retryDelay = 5 * time.Second
+
+	// pollInterval is the interval to check if chains are started.
+	pollInterval = time.Second
+)
+
+// Sandbox serves two chains and relays packets in between.
+type Sandbox struct {
+	ca             cosmosaccount.Registry
+	chains         [2]*sandboxChain
+	chainOptions   []chain.Option
+	serveOptions   []chain.ServeOption
+	channelOptions []relayer.ChannelOption
+
+	// pathID is the id of the path that links the chains, it is empty until the chains are linked.
+	pathID string
+}
+
+// sandboxChain is a chain served in the sandbox.
+type sandboxChain struct {
+	*chain.Chain
+
+	id   string
+	conf chainconfig.Config
+}
+
+// Option configures Sandbox.
+type Option func(*Sandbox)
+
+// ChainOptions configures the served chains.
+func ChainOptions(options ...chain.Option) Option {
+	return func(s *Sandbox) {
+		s.chainOptions = append(s.chainOptions, options...)
+	}
+}
+
+// ServeOptions configures serving the chains.
+func ServeOptions(options ...chain.ServeOption) Option {
+	return func(s *Sandbox) {
+		s.serveOptions = append(s.serveOptions, options...)
+	}
+}
+
+// ChannelOptions configures the channel that is created between the chains.
+func ChannelOptions(options ...relayer.ChannelOption) Option {
+	return func(s *Sandbox) {
+		s.channelOptions = append(s.channelOptions, options...)
+	}
+}
+
+// New creates a sandbox for the chains configured with the config files at configA and configB.
+// apps of the chains are located in the directories of their config files. ports of chain b
+// are shifted when they overlap with chain a's and relayer accounts are stored in ca.
+func New(ca cosmosaccount.Registry, configA, configB string, options ...Option) (*Sandbox, error) {
+	s := &Sandbox{ca: ca}
+
+	for _, apply := range options {
+		apply(s)
+	}
+
+	for _, configPath := range []string{configA, configB} {
+		if _, err := os.Stat(configPath); err != nil {
+			return nil, err
+		}
+	}
+
+	a, err := s.newChain(configA)
+	if err != nil {
+		return nil, err
+	}
+
+	confB, err := chainconfig.ParseFile(configB)
+	if err != nil {
+		return nil, err
+	}
+
+	offset, err := portOffset(a.conf, confB)
+	if err != nil {
+		return nil, err
+	}
+
+	override := chain.ConfigOverride(func(conf *chainconfig.Config) {
+		// the ports are validated while calculating the offset.
+		_ = shiftPorts(conf, offset)
+	})
+
+	b, err := s.newChain(configB, override)
+	if err != nil {
+		return nil, err
+	}
+
+	if a.id == b.id {
+		return nil, fmt.Errorf("both chains have the same id %q, set a different genesis.chain_id in one of the configs", a.id)
+	}
+
+	homeA, err := a.Home()
+	if err != nil {
+		return nil, err
+	}
+	homeB, err := b.Home()
+	if err != nil {
+		return nil, err
+	}
+	if homeA == homeB {
+		if b, err = s.newChain(configB, override, chain.HomePath(fmt.Sprintf("%s-%s", homeB, b.id))); err != nil {
+			return nil, err
+		}
+	}
+
+	s.chains = [2]*sandboxChain{a, b}
+
+	return s, nil
+}
+
+// newChain creates a chain for the config file at configPath.
+func (s *Sandbox) newChain(configPath string, options ...chain.Option) (*sandboxChain, error) {
+	configPath, err := filepath.Abs(configPath)
+	if err != nil {
+		return nil, err
+	}
+
+	options = append(append([]chain.Option{}, s.chainOptions...), options...)
+	options = append(options, chain.ConfigFile(configPath))
+
+	c, err := chain.New(filepath.Dir(configPath), options...)
+	if err != nil {
+		return nil, err
+	}
+
+	sc := &sandboxChain{Chain: c}
+
+	if sc.id, err = c.ID(); err != nil {
+		return nil, err
+	}
+	if sc.conf, err = c.Config(); err != nil {
+		return nil, err
+	}
+
+	return sc, nil
+}
+
+// Run serves the chains, links them and relays packets in between until ctx is canceled.
+func (s *Sandbox) Run(ctx context.Context) error {
+	g, ctx := errgroup.WithContext(ctx)
+
+	for _, c := range s.chains {
+		c := c
+		g.Go(func() error {
+			return c.Serve(ctx, s.serveOptions...)
+		})
+	}
+
+	g.Go(func() error {
+		for {
+			err := s.relay(ctx)
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+
+			fmt.Printf("⚠️  Relaying stopped: %s, retrying in %s...\n", err, retryDelay)
+
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(retryDelay):
+			}
+		}
+	})
+
+	return g.Wait()
+}
+
+// relay relays packets in between the chains. it keeps relaying on the existing path unless its
+// link is gone, e.g. when the state of the chains is reset, then it funds the relayer accounts and
+// links the chains with a new path.
+func (s *Sandbox) relay(ctx context.Context) error {
+	r := relayer.New(s.ca)
+
+	for _, c := range s.chains {
+		if err := waitForChain(ctx, xurl.HTTP(c.conf.Host.RPC)); err != nil {
+			return err
+		}
+	}
+
+	if s.pathID != "" {
+		err := r.CheckLink(ctx, s.pathID)
+		if err == nil {
+			fmt.Println("📨 Relaying packets...")
+			return r.Start(ctx, s.pathID)
+		}
+		if !errors.Is(err, relayer.ErrLinkGone) {
+			return err
+		}
+
+		fmt.Printf("⚠️  %s, linking the chains again...\n", err)
+		s.pathID = ""
+	}
+
+	id, err := s.link(ctx, r)
+	if err != nil {
+		return err
+	}
+	s.pathID = id
+
+	fmt.Println("📨 Relaying packets...")
+
+	return r.Start(ctx, id)
+}
+
+// link funds the relayer accounts and links the chains with a new path, it returns the id of the path.
+func (s *Sandbox) link(ctx context.Context, r relayer.Relayer) (pathID string, err error) {
+	if err := s.removePaths(); err != nil {
+		return "", err
+	}
+
+	var chains [2]*relayer.Chain
+
+	for i, c := range s.chains {
+		accountName, prefix, denom, err := s.fund(ctx, c)
+		if err != nil {
+			return "", err
+		}
+
+		if chains[i], _, err = r.NewChain(ctx, accountName, xurl.HTTP(c.conf.Host.RPC),
+			relayer.WithGasPrice("0"+denom),
+			relayer.WithAddressPrefix(prefix),
+		); err != nil {
+			return "", err
+		}
+	}
+
+	id, err := chains[0].Connect(ctx, chains[1], s.channelOptions...)
+	if err != nil {
+		return "", err
+	}
+
+	if err := r.Link(ctx, id); err != nil {
+		return "", err
+	}
+
+	path, err := r.GetPath(ctx, id)
+	if err != nil {
+		return "", err
+	}
+
+	fmt.Printf("🔗 Linked %s (port: %s, channel: %s) and %s (port: %s, channel: %s)\n",
+		path.Src.ChainID, path.Src.PortID, path.Src.ChannelID,
+		path.Dst.ChainID, path.Dst.PortID, path.Dst.ChannelID,
+	)
+
+	return id, nil
+}
+
+// removePaths removes the paths in between the chains from the relayer config, so they are
+// linked from scratch in case the state of the chains is reset. chains that are not used
+// by other paths are removed as well to be added back with their latest settings.
+func (s *Sandbox) removePaths() error {
+	conf, err := relayerconf.Get()
+	if err != nil {
+		return err
+	}
+
+	a, b := s.chains[0].id, s.chains[1].id
+
+	for _, path := range conf.ChainPaths(a) {
+		if path.Src.ChainID == b || path.Dst.ChainID == b {
+			if err := conf.RemovePath(path.ID); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, id := range []string{a, b} {
+		if _, err := conf.ChainByID(id); err != nil || len(conf.ChainPaths(id)) > 0 {
+			continue
+		}
+		if err := conf.RemoveChain(id); err != nil {
+			return err
+		}
+	}
+
+	return relayerconf.Save(conf)
+}
+
+// fund makes sure that the relayer account of the chain has balances by sending tokens from
+// the validator account that is funded in the genesis. it returns the name of the relayer
+// account with the address prefix and staking denom of the chain.
+func (s *Sandbox) fund(ctx context.Context, c *sandboxChain) (accountName, prefix, denom string, err error) {
+	commands, err := c.Commands(ctx)
+	if err != nil {
+		return "", "", "", err
+	}
+
+	validator, err := commands.ShowAccount(ctx, c.conf.Validator.Name)
+	if err != nil {
+		return "", "", "", err
+	}
+
+	if prefix, _, err = bech32.DecodeAndConvert(validator.Address); err != nil {
+		return "", "", "", err
+	}
+
+	if _, denom, err = cosmoscoin.Parse(c.conf.Validator.Staked); err != nil {
+		return "", "", "", err
+	}
+
+	accountName = fmt.Sprintf("%s-relayer", c.id)
+
+	account, err := s.ca.GetByName(accountName)
+	var accErr *cosmosaccount.AccountDoesNotExistError
+	if errors.As(err, &accErr) {
+		account, _, err = s.ca.Create(accountName)
+	}
+	if err != nil {
+		return "", "", "", err
+	}
+
+	client, err := cosmosclient.New(ctx, cosmosclient.WithNodeAddress(xurl.HTTP(c.conf.Host.RPC)))
+	if err != nil {
+		return "", "", "", err
+	}
+
+	address := account.Address(prefix)
+
+	balances, err := client.BankBalances(ctx, address)
+	if err != nil {
+		return "", "", "", err
+	}
+
+	if balances.AmountOf(denom).IsZero() {
+		amount := fmt.Sprintf("%d%s", relayerFunds, denom)
+		if err := commands.BankSend(ctx, validator.Address, address, amount); err != nil {
+			return "", "", "", err
+		}
+		fmt.Printf("💸 Sent %s to relayer account %s on %q\n", amount, address, c.id)
+	}
+
+	return accountName, prefix, denom, nil
+}
+
+// waitForChain waits until the chain at rpcAddress produces blocks.
+func waitForChain(ctx context.Context, rpcAddress string) error {
+	client, err := rpchttp.New(rpcAddress, "/websocket")
+	if err != nil {
+		return err
+	}
+
+	for {
+		if status, err := client.Status(ctx); err == nil && status.SyncInfo.LatestBlockHeight > 1 {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(pollInterval):
+		}
+	}
+}
@@ -0,0 +1,102 @@
+package ibcsandbox
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/tendermint/starport/starport/chainconfig"
+)
+
+// portShift is the amount that ports are shifted by until they don't overlap.
+const portShift = 10
+
+// portOffset returns the offset to shift the ports of b so they don't overlap with a's.
+func portOffset(a, b chainconfig.Config) (int, error) {
+	portsA, err := ports(a)
+	if err != nil {
+		return 0, err
+	}
+	portsB, err := ports(b)
+	if err != nil {
+		return 0, err
+	}
+
+	used := make(map[int]bool)
+	for _, port := range portsA {
+		used[port] = true
+	}
+
+	for offset := 0; ; offset += portShift {
+		overlaps := false
+		for _, port := range portsB {
+			if used[port+offset] {
+				overlaps = true
+				break
+			}
+		}
+		if !overlaps {
+			return offset, nil
+		}
+	}
+}
+
+// ports returns the ports of the servers configured in conf.
+func ports(conf chainconfig.Config) ([]int, error) {
+	var ports []int
+	for _, addr := range hostAddresses(&conf) {
+		port, err := addressPort(*addr)
+		if err != nil {
+			return nil, err
+		}
+		ports = append(ports, port)
+	}
+	if conf.Faucet.Port != 0 {
+		ports = append(ports, conf.Faucet.Port)
+	}
+	return ports, nil
+}
+
+// shiftPorts increases the ports of the servers configured in conf by offset.
+func shiftPorts(conf *chainconfig.Config, offset int) error {
+	if offset == 0 {
+		return nil
+	}
+	for _, addr := range hostAddresses(conf) {
+		port, err := addressPort(*addr)
+		if err != nil {
+			return err
+		}
+		*addr = fmt.Sprintf("%s:%d", (*addr)[:strings.LastIndex(*addr, ":")], port+offset)
+	}
+	if conf.Faucet.Port != 0 {
+		conf.Faucet.Port += offset
+	}
+	return nil
+}
+
+// hostAddresses returns the addresses of the servers configured in conf.
+func hostAddresses(conf *chainconfig.Config) []*string {
+	return []*string{
+		&conf.Host.RPC,
+		&conf.Host.P2P,
+		&conf.Host.Prof,
+		&conf.Host.GRPC,
+		&conf.Host.GRPCWeb,
+		&conf.Host.API,
+		&conf.Faucet.Host,
+	}
+}
+
+// addressPort returns the port of addr in host:port format.
+func addressPort(addr string) (int, error) {
+	i := strings.LastIndex(addr, ":")
+	if i == -1 {
+		return 0, fmt.Errorf("address %q doesn't have a port", addr)
+	}
+	port, err := strconv.Atoi(addr[i+1:])
+	if err != nil {
+		return 0, fmt.Errorf("address %q has an invalid port: %w", addr, err)
+	}
+	return port, nil
+}
@@ -0,0 +1,34 @@
+package ibcsandbox
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/starport/starport/chainconfig"
+)
+
+func TestPortOffset(t *testing.T) {
+	a := chainconfig.DefaultConf
+
+	offset, err := portOffset(a, a)
+	require.NoError(t, err)
+	require.Equal(t, portShift, offset)
+
+	b := chainconfig.DefaultConf
+	require.NoError(t, shiftPorts(&b, offset))
+	require.Equal(t, "0.0.0.0:26667", b.Host.RPC)
+	require.Equal(t, "0.0.0.0:4510", b.Faucet.Host)
+
+	offset, err = portOffset(a, b)
+	require.NoError(t, err)
+	require.Equal(t, 0, offset)
+
+	b.Host.API = "0.0.0.0:26657"
+	offset, err = portOffset(a, b)
+	require.NoError(t, err)
+	require.Equal(t, portShift, offset)
+
+	b.Host.API = "localhost"
+	_, err = portOffset(a, b)
+	require.Error(t, err)
+}
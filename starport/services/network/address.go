@@ -0,0 +1,47 @@
+package network
+
+import (
+	"context"
+	"os"
+
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+)
+
+// addressPrefix returns the address prefix of the chain. it is read from an account created in
+// a temporary keyring, so it can be found before the chain has any keys.
+func (b *Blockchain) addressPrefix(ctx context.Context) (string, error) {
+	tmpHome, err := os.MkdirTemp("", "")
+	if err != nil {
+		return "", err
+	}
+	defer os.RemoveAll(tmpHome)
+
+	c, err := b.tmpChain(tmpHome)
+	if err != nil {
+		return "", err
+	}
+
+	commands, err := c.Commands(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	account, err := commands.AddAccount(ctx, "prefix", "", "")
+	if err != nil {
+		return "", err
+	}
+
+	prefix, _, err := bech32.DecodeAndConvert(account.Address)
+	return prefix, err
+}
+
+// chainAddress converts the address of an account on SPN to its address on a chain with prefix.
+// addresses are kept in the SPN format in requests and only converted when they are added to
+// the genesis of the chain.
+func chainAddress(spnAddress, prefix string) (string, error) {
+	_, addr, err := bech32.DecodeAndConvert(spnAddress)
+	if err != nil {
+		return "", err
+	}
+	return bech32.ConvertAndEncode(prefix, addr)
+}
@@ -0,0 +1,25 @@
+package network
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChainAddress(t *testing.T) {
+	addr := []byte("0123456789abcdefghij")
+
+	spnAddress, err := bech32.ConvertAndEncode(SPNAddressPrefix, addr)
+	require.NoError(t, err)
+
+	marsAddress, err := bech32.ConvertAndEncode("mars", addr)
+	require.NoError(t, err)
+
+	address, err := chainAddress(spnAddress, "mars")
+	require.NoError(t, err)
+	require.Equal(t, marsAddress, address)
+
+	_, err = chainAddress("spn1invalid", "mars")
+	require.Error(t, err)
+}
@@ -35,6 +35,7 @@ func (b *Blockchain) setup(
 	chainID,
 	home string,
 	keyringBackend chaincmd.KeyringBackend,
+	keyringPassphrase string,
 ) error {
 	b.builder.ev.Send(events.New(events.StatusOngoing, "Initializing the blockchain"))
 
@@ -53,7 +54,10 @@ func (b *Blockchain) setup(
 		keyringBackend = chaincmd.KeyringBackendTest
 	}
 
-	chainOption = append(chainOption, chain.KeyringBackend(keyringBackend))
+	chainOption = append(chainOption,
+		chain.KeyringBackend(keyringBackend),
+		chain.KeyringPassphrase(keyringPassphrase),
+	)
 
 	chain, err := chain.New(b.appPath, chainOption...)
 	if err != nil {
@@ -187,7 +191,7 @@ func (b *Blockchain) Publish(ctx context.Context, options ...CreateOption) error
 	}
 
 	_, err = profiletypes.
-		NewQueryClient(b.builder.cosmos.Context).
+		NewQueryClient(b.builder.cosmos.ClientConn()).
 		CoordinatorByAddress(ctx, &profiletypes.QueryGetCoordinatorByAddressRequest{
 			Address: b.builder.account.Address(SPNAddressPrefix),
 		})
@@ -200,7 +204,7 @@ func (b *Blockchain) Publish(ctx context.Context, options ...CreateOption) error
 			"",
 			"",
 		)
-		if _, err := b.builder.cosmos.BroadcastTx(b.builder.account.Name, msgCreateCoordinator); err != nil {
+		if _, err := b.builder.cosmos.BroadcastTx(ctx, b.builder.account.Name, msgCreateCoordinator); err != nil {
 			return err
 		}
 	}
@@ -215,7 +219,7 @@ func (b *Blockchain) Publish(ctx context.Context, options ...CreateOption) error
 		false,
 		0,
 	)
-	_, err = b.builder.cosmos.BroadcastTx(b.builder.account.Name, msgCreateChain)
+	_, err = b.builder.cosmos.BroadcastTx(ctx, b.builder.account.Name, msgCreateChain)
 	return err
 }
 
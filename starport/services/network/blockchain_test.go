@@ -0,0 +1,100 @@
+package network_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/starport/starport/pkg/cosmosaccount"
+	"github.com/tendermint/starport/starport/services/network"
+	"github.com/tendermint/starport/starport/services/network/networktest"
+)
+
+// newChainRepo creates a git repo with the source of a chain and returns its path with the hash of its commit.
+func newChainRepo(t *testing.T) (path, hash string) {
+	path = t.TempDir()
+
+	err := os.WriteFile(filepath.Join(path, "go.mod"), []byte(`module github.com/mars/mars
+
+go 1.16
+
+require github.com/cosmos/cosmos-sdk v0.44.0
+`), 0644)
+	require.NoError(t, err)
+
+	repo, err := git.PlainInit(path, false)
+	require.NoError(t, err)
+	wt, err := repo.Worktree()
+	require.NoError(t, err)
+	_, err = wt.Add("go.mod")
+	require.NoError(t, err)
+	h, err := wt.Commit("init", &git.CommitOptions{
+		Author: &object.Signature{Name: "mars", Email: "mars@mars.com", When: time.Now()},
+	})
+	require.NoError(t, err)
+
+	return path, h.String()
+}
+
+func TestPublish(t *testing.T) {
+	ctx := context.Background()
+
+	registry, err := cosmosaccount.New(cosmosaccount.WithHome(t.TempDir()))
+	require.NoError(t, err)
+
+	coordinator, _, err := registry.Create("coordinator")
+	require.NoError(t, err)
+
+	ln := networktest.New(registry)
+
+	builder, err := network.New(ln, coordinator)
+	require.NoError(t, err)
+
+	path, hash := newChainRepo(t)
+
+	for i := 0; i < 2; i++ {
+		blockchain, err := builder.Blockchain(ctx, network.SourceRemote(path), network.InitializationHomePath(t.TempDir()))
+		require.NoError(t, err)
+
+		// the coordinator is created with the first chain and reused afterwards.
+		require.NoError(t, blockchain.Publish(ctx))
+	}
+
+	genesis := []byte(`{"chain_id":"mars"}`)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Write(genesis)
+	}))
+	defer server.Close()
+
+	blockchain, err := builder.Blockchain(ctx, network.SourceRemote(path), network.InitializationHomePath(t.TempDir()))
+	require.NoError(t, err)
+	require.NoError(t, blockchain.Publish(ctx, network.WithCustomGenesisFromURL(server.URL), network.WithNoCheck()))
+
+	chains, err := builder.ChainLaunches(ctx)
+	require.NoError(t, err)
+	require.Len(t, chains, 3)
+
+	for _, chain := range chains {
+		require.Equal(t, "mars", chain.GenesisChainID)
+		require.Equal(t, path, chain.SourceURL)
+		require.Equal(t, hash, chain.SourceHash)
+		require.Equal(t, chains[0].CoordinatorID, chain.CoordinatorID)
+	}
+
+	require.Nil(t, chains[0].InitialGenesis.GetGenesisURL())
+
+	sum := sha256.Sum256(genesis)
+	genesisURL := chains[2].InitialGenesis.GetGenesisURL()
+	require.NotNil(t, genesisURL)
+	require.Equal(t, server.URL, genesisURL.Url)
+	require.Equal(t, hex.EncodeToString(sum[:]), genesisURL.Hash)
+}
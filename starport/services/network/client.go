@@ -0,0 +1,62 @@
+package network
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"github.com/tendermint/starport/starport/pkg/cosmosaccount"
+	"github.com/tendermint/starport/starport/pkg/cosmosclient"
+)
+
+// CosmosClient is the client of the launch network used by Builder to query it and to send
+// transactions to it.
+type CosmosClient interface {
+	// ClientConn returns the gRPC connection to query the launch network.
+	ClientConn() gogogrpc.ClientConn
+
+	// AccountRegistry returns the registry of the accounts that sign transactions.
+	AccountRegistry() cosmosaccount.Registry
+
+	// BroadcastTx signs msgs with the account named accountName and broadcasts them in a single tx.
+	BroadcastTx(ctx context.Context, accountName string, msgs ...sdk.Msg) (*sdk.TxResponse, error)
+}
+
+// cosmosClient is a CosmosClient for a launch network served by a node.
+type cosmosClient struct {
+	client cosmosclient.Client
+}
+
+// WrapCosmosClient returns a CosmosClient that uses client to connect to the node of a launch network.
+func WrapCosmosClient(client cosmosclient.Client) CosmosClient {
+	return cosmosClient{client}
+}
+
+func (c cosmosClient) ClientConn() gogogrpc.ClientConn {
+	return c.client.Context
+}
+
+func (c cosmosClient) AccountRegistry() cosmosaccount.Registry {
+	return c.client.AccountRegistry
+}
+
+func (c cosmosClient) BroadcastTx(ctx context.Context, accountName string, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+	return c.client.BroadcastTx(ctx, accountName, msgs...)
+}
+
+// fetchPages calls fetch for every page of a paginated query until the last page is fetched.
+// fetch queries the page with pagination, collects its items and returns the pagination of the response.
+func fetchPages(fetch func(pagination *query.PageRequest) (*query.PageResponse, error)) error {
+	var key []byte
+	for {
+		res, err := fetch(&query.PageRequest{Key: key})
+		if err != nil {
+			return err
+		}
+		if res == nil || len(res.NextKey) == 0 {
+			return nil
+		}
+		key = res.NextKey
+	}
+}
@@ -0,0 +1,217 @@
+package network
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+	"github.com/tendermint/starport/starport/pkg/chaincmd"
+	"github.com/tendermint/starport/starport/pkg/events"
+)
+
+// joinOptions holds info about how to join a chain.
+type joinOptions struct {
+	accountCoins sdk.Coins
+	moniker      string
+}
+
+// JoinOption configures joining a chain.
+type JoinOption func(*joinOptions)
+
+// WithAccountCoins requests the genesis account of the validator with coins instead of the self delegation.
+func WithAccountCoins(coins sdk.Coins) JoinOption {
+	return func(o *joinOptions) {
+		o.accountCoins = coins
+	}
+}
+
+// WithMoniker sets the moniker of the validator.
+func WithMoniker(moniker string) JoinOption {
+	return func(o *joinOptions) {
+		o.moniker = moniker
+	}
+}
+
+// Join generates a gentx for the chain with launchID from the account of the builder that
+// self delegates selfDelegation and submits requests to SPN to add the account to the genesis
+// and to add the validator with its node reachable at peerAddress.
+func (b *Blockchain) Join(ctx context.Context, launchID uint64, selfDelegation sdk.Coin, peerAddress string,
+	options ...JoinOption) error {
+	o := joinOptions{accountCoins: sdk.NewCoins(selfDelegation)}
+	for _, apply := range options {
+		apply(&o)
+	}
+
+	chain, err := b.builder.ChainLaunch(ctx, launchID)
+	if err != nil {
+		return err
+	}
+	if chain.LaunchTriggered {
+		return fmt.Errorf("chain %d is already launched", launchID)
+	}
+
+	if !b.isInitialized {
+		if err := b.Init(ctx); err != nil {
+			return err
+		}
+	}
+
+	if genesisURL := chain.InitialGenesis.GetGenesisURL(); genesisURL != nil {
+		if err := b.initGenesisFromURL(ctx, genesisURL.Url, genesisURL.Hash); err != nil {
+			return err
+		}
+	}
+
+	b.builder.ev.Send(events.New(events.StatusOngoing, "Generating the gentx"))
+
+	commands, err := b.chain.Commands(ctx)
+	if err != nil {
+		return err
+	}
+
+	// the gentx is signed with the account of the builder, so it needs to be in the chain's keyring.
+	if err := b.copyAccountToChain(); err != nil {
+		return err
+	}
+
+	// the account is requested with its SPN address, it is only converted to the chain's address
+	// to add it to the genesis, the same way as the approved accounts are added when preparing.
+	address := b.builder.account.Address(SPNAddressPrefix)
+
+	prefix, err := b.addressPrefix(ctx)
+	if err != nil {
+		return err
+	}
+
+	genesisAddress, err := chainAddress(address, prefix)
+	if err != nil {
+		return err
+	}
+
+	// gentxs can only delegate coins of the accounts in the genesis.
+	if err := commands.AddGenesisAccount(ctx, genesisAddress, o.accountCoins.String()); err != nil {
+		return err
+	}
+
+	var gentxOptions []chaincmd.GentxOption
+	if o.moniker != "" {
+		gentxOptions = append(gentxOptions, chaincmd.GentxWithMoniker(o.moniker))
+	}
+
+	gentxPath, err := commands.Gentx(ctx, b.builder.account.Name, selfDelegation.String(), gentxOptions...)
+	if err != nil {
+		return err
+	}
+
+	gentx, err := os.ReadFile(gentxPath)
+	if err != nil {
+		return err
+	}
+
+	consPubKey, err := b.consensusPubKey()
+	if err != nil {
+		return err
+	}
+
+	nodeID, err := commands.ShowNodeID(ctx)
+	if err != nil {
+		return err
+	}
+
+	b.builder.ev.Send(events.New(events.StatusDone, "Gentx generated"))
+
+	return b.builder.RequestJoin(ctx, launchID, o.accountCoins, gentx, consPubKey, selfDelegation,
+		fmt.Sprintf("%s@%s", nodeID, peerAddress))
+}
+
+// RequestJoin submits requests to SPN in a single tx to add the account of the builder with accountCoins
+// to the genesis of the chain with launchID and to add it as a validator with gentx that self delegates
+// selfDelegation. the validator's node is reachable at peer and signs blocks with consPubKey.
+func (b *Builder) RequestJoin(ctx context.Context, launchID uint64, accountCoins sdk.Coins, gentx, consPubKey []byte,
+	selfDelegation sdk.Coin, peer string) error {
+	chain, err := b.ChainLaunch(ctx, launchID)
+	if err != nil {
+		return err
+	}
+	if chain.LaunchTriggered {
+		return fmt.Errorf("chain %d is already launched", launchID)
+	}
+
+	b.ev.Send(events.New(events.StatusOngoing, "Submitting requests"))
+
+	address := b.account.Address(SPNAddressPrefix)
+
+	if _, err := b.cosmos.BroadcastTx(ctx, b.account.Name,
+		launchtypes.NewMsgRequestAddAccount(address, launchID, accountCoins),
+		launchtypes.NewMsgRequestAddValidator(address, launchID, gentx, consPubKey, selfDelegation, peer),
+	); err != nil {
+		return err
+	}
+
+	b.ev.Send(events.New(events.StatusDone, "Requests submitted"))
+
+	return nil
+}
+
+// initGenesisFromURL replaces the genesis of the chain with the one at url after checking its hash.
+func (b *Blockchain) initGenesisFromURL(ctx context.Context, url, hash string) error {
+	genesis, genesisHash, err := genesisAndHashFromURL(ctx, url)
+	if err != nil {
+		return err
+	}
+	if genesisHash != hash {
+		return fmt.Errorf("hash of the genesis at %s doesn't match with the one on SPN", url)
+	}
+
+	genesisPath, err := b.chain.GenesisPath()
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(genesisPath, genesis, 0644)
+}
+
+// copyAccountToChain copies the account of the builder to the chain's keyring.
+func (b *Blockchain) copyAccountToChain() error {
+	if b.builder.account.IsRemote() {
+		return errors.New("remote accounts cannot sign gentxs, use an account with a private key")
+	}
+
+	chainRegistry, err := b.chain.AccountRegistry()
+	if err != nil {
+		return err
+	}
+
+	_, err = b.builder.cosmos.AccountRegistry().CopyTo(chainRegistry, b.builder.account.Name)
+	return err
+}
+
+// consensusPubKey returns the consensus public key of the chain's validator node.
+func (b *Blockchain) consensusPubKey() ([]byte, error) {
+	home, err := b.chain.Home()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(filepath.Join(home, "config/priv_validator_key.json"))
+	if err != nil {
+		return nil, err
+	}
+
+	var key struct {
+		PubKey struct {
+			Value string `json:"value"`
+		} `json:"pub_key"`
+	}
+	if err := json.Unmarshal(data, &key); err != nil {
+		return nil, err
+	}
+
+	return base64.StdEncoding.DecodeString(key.PubKey.Value)
+}
@@ -0,0 +1,80 @@
+package network_test
+
+import (
+	"context"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+	profiletypes "github.com/tendermint/spn/x/profile/types"
+	"github.com/tendermint/starport/starport/pkg/cosmosaccount"
+	"github.com/tendermint/starport/starport/services/network"
+	"github.com/tendermint/starport/starport/services/network/networktest"
+)
+
+func TestRequestJoin(t *testing.T) {
+	ctx := context.Background()
+
+	registry, err := cosmosaccount.New(cosmosaccount.WithHome(t.TempDir()))
+	require.NoError(t, err)
+
+	coordinator, _, err := registry.Create("coordinator")
+	require.NoError(t, err)
+	validator, _, err := registry.Create("validator")
+	require.NoError(t, err)
+
+	var (
+		ln                 = networktest.New(registry)
+		coordinatorAddress = coordinator.Address(network.SPNAddressPrefix)
+		validatorAddress   = validator.Address(network.SPNAddressPrefix)
+		selfDelegation     = sdk.NewInt64Coin("stake", 100)
+		coins              = sdk.NewCoins(sdk.NewInt64Coin("stake", 200))
+	)
+
+	_, err = ln.BroadcastTx(ctx, coordinator.Name,
+		profiletypes.NewMsgCreateCoordinator(coordinatorAddress, "", "", ""),
+		launchtypes.NewMsgCreateChain(coordinatorAddress, "mars-1", "https://mars.com", "abc", "", "", false, 0),
+		launchtypes.NewMsgCreateChain(coordinatorAddress, "venus-1", "https://venus.com", "abc", "", "", false, 0),
+		launchtypes.NewMsgTriggerLaunch(coordinatorAddress, 1, 100),
+	)
+	require.NoError(t, err)
+
+	coordinatorBuilder, err := network.New(ln, coordinator)
+	require.NoError(t, err)
+	validatorBuilder, err := network.New(ln, validator)
+	require.NoError(t, err)
+
+	err = validatorBuilder.RequestJoin(ctx, 0, coins, []byte("gentx"), []byte("key"), selfDelegation, "node@1.2.3.4")
+	require.NoError(t, err)
+
+	err = validatorBuilder.RequestJoin(ctx, 1, coins, []byte("gentx"), []byte("key"), selfDelegation, "node@1.2.3.4")
+	require.Error(t, err, "launched chains cannot be joined")
+
+	err = validatorBuilder.RequestJoin(ctx, 2, coins, []byte("gentx"), []byte("key"), selfDelegation, "node@1.2.3.4")
+	require.Error(t, err, "chain doesn't exist")
+
+	requests, err := coordinatorBuilder.Requests(ctx, 0)
+	require.NoError(t, err)
+	require.Len(t, requests, 2)
+	for _, request := range requests {
+		require.Equal(t, validatorAddress, request.Creator)
+	}
+
+	require.NoError(t, coordinatorBuilder.ApproveRequests(ctx, 0, requests[0].RequestID, requests[1].RequestID))
+
+	accounts, err := coordinatorBuilder.GenesisAccounts(ctx, 0)
+	require.NoError(t, err)
+	require.Equal(t, []launchtypes.GenesisAccount{{ChainID: 0, Address: validatorAddress, Coins: coins}}, accounts)
+
+	validators, err := coordinatorBuilder.GenesisValidators(ctx, 0)
+	require.NoError(t, err)
+	require.Equal(t, []launchtypes.GenesisValidator{{
+		ChainID:        0,
+		Address:        validatorAddress,
+		GenTx:          []byte("gentx"),
+		ConsPubKey:     []byte("key"),
+		SelfDelegation: selfDelegation,
+		Peer:           "node@1.2.3.4",
+	}}, validators)
+}
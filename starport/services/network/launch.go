@@ -0,0 +1,111 @@
+package network
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+)
+
+// ChainLaunch returns the chain with launchID from SPN.
+func (b *Builder) ChainLaunch(ctx context.Context, launchID uint64) (launchtypes.Chain, error) {
+	res, err := launchtypes.NewQueryClient(b.cosmos.ClientConn()).Chain(ctx, &launchtypes.QueryGetChainRequest{
+		Id: launchID,
+	})
+	if err != nil {
+		return launchtypes.Chain{}, err
+	}
+	return res.Chain, nil
+}
+
+// ChainLaunches returns all the chains published on SPN.
+func (b *Builder) ChainLaunches(ctx context.Context) ([]launchtypes.Chain, error) {
+	var chains []launchtypes.Chain
+
+	err := fetchPages(func(pagination *query.PageRequest) (*query.PageResponse, error) {
+		res, err := launchtypes.NewQueryClient(b.cosmos.ClientConn()).ChainAll(ctx, &launchtypes.QueryAllChainRequest{
+			Pagination: pagination,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		chains = append(chains, res.Chain...)
+
+		return res.Pagination, nil
+	})
+
+	return chains, err
+}
+
+// GenesisAccounts returns the approved genesis accounts of the chain with launchID.
+func (b *Builder) GenesisAccounts(ctx context.Context, launchID uint64) ([]launchtypes.GenesisAccount, error) {
+	var accounts []launchtypes.GenesisAccount
+
+	err := fetchPages(func(pagination *query.PageRequest) (*query.PageResponse, error) {
+		res, err := launchtypes.NewQueryClient(b.cosmos.ClientConn()).GenesisAccountAll(ctx, &launchtypes.QueryAllGenesisAccountRequest{
+			ChainID:    launchID,
+			Pagination: pagination,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		accounts = append(accounts, res.GenesisAccount...)
+
+		return res.Pagination, nil
+	})
+
+	return accounts, err
+}
+
+// VestingAccounts returns the approved vesting accounts of the chain with launchID.
+func (b *Builder) VestingAccounts(ctx context.Context, launchID uint64) ([]launchtypes.VestingAccount, error) {
+	var accounts []launchtypes.VestingAccount
+
+	err := fetchPages(func(pagination *query.PageRequest) (*query.PageResponse, error) {
+		res, err := launchtypes.NewQueryClient(b.cosmos.ClientConn()).VestingAccountAll(ctx, &launchtypes.QueryAllVestingAccountRequest{
+			ChainID:    launchID,
+			Pagination: pagination,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		accounts = append(accounts, res.VestingAccount...)
+
+		return res.Pagination, nil
+	})
+
+	return accounts, err
+}
+
+// GenesisValidators returns the approved genesis validators of the chain with launchID.
+func (b *Builder) GenesisValidators(ctx context.Context, launchID uint64) ([]launchtypes.GenesisValidator, error) {
+	var validators []launchtypes.GenesisValidator
+
+	err := fetchPages(func(pagination *query.PageRequest) (*query.PageResponse, error) {
+		res, err := launchtypes.NewQueryClient(b.cosmos.ClientConn()).GenesisValidatorAll(ctx, &launchtypes.QueryAllGenesisValidatorRequest{
+			ChainID:    launchID,
+			Pagination: pagination,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		validators = append(validators, res.GenesisValidator...)
+
+		return res.Pagination, nil
+	})
+
+	return validators, err
+}
+
+// SourceLaunch makes source determined by the chain launched on SPN, with its locked source code.
+func SourceLaunch(chain launchtypes.Chain) SourceOption {
+	return func(o *initOptions) {
+		o.chainID = chain.GenesisChainID
+		o.url = chain.SourceURL
+		o.hash = chain.SourceHash
+	}
+}
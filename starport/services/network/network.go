@@ -8,7 +8,6 @@ import (
 	"github.com/go-git/go-git/v5/plumbing"
 	"github.com/tendermint/starport/starport/pkg/chaincmd"
 	"github.com/tendermint/starport/starport/pkg/cosmosaccount"
-	"github.com/tendermint/starport/starport/pkg/cosmosclient"
 	"github.com/tendermint/starport/starport/pkg/events"
 )
 
@@ -19,7 +18,7 @@ const (
 // Builder is network builder.
 type Builder struct {
 	ev      events.Bus
-	cosmos  cosmosclient.Client
+	cosmos  CosmosClient
 	account cosmosaccount.Account
 }
 
@@ -33,7 +32,7 @@ func CollectEvents(ev events.Bus) Option {
 }
 
 // New creates a Builder.
-func New(cosmos cosmosclient.Client, account cosmosaccount.Account, options ...Option) (*Builder, error) {
+func New(cosmos CosmosClient, account cosmosaccount.Account, options ...Option) (*Builder, error) {
 	b := &Builder{
 		cosmos:  cosmos,
 		account: account,
@@ -53,6 +52,7 @@ type initOptions struct {
 	mustNotInitializedBefore bool
 	homePath                 string
 	keyringBackend           chaincmd.KeyringBackend
+	keyringPassphrase        string
 }
 
 // SourceOption sets the source for blockchain.
@@ -121,6 +121,14 @@ func InitializationKeyringBackend(keyringBackend chaincmd.KeyringBackend) InitOp
 	}
 }
 
+// InitializationKeyringPassphrase provides the passphrase to unlock the keyring of the blockchain
+// when the file keyring backend is used.
+func InitializationKeyringPassphrase(passphrase string) InitOption {
+	return func(o *initOptions) {
+		o.keyringPassphrase = passphrase
+	}
+}
+
 // Blockchain initializes a blockchain from source and options.
 func (b *Builder) Blockchain(ctx context.Context, source SourceOption, options ...InitOption) (*Blockchain, error) {
 	var o initOptions
@@ -144,7 +152,7 @@ func (b *Builder) Blockchain(ctx context.Context, source SourceOption, options .
 		hash:    hash,
 		builder: b,
 	}
-	return bc, bc.setup(o.chainID, o.homePath, o.keyringBackend)
+	return bc, bc.setup(o.chainID, o.homePath, o.keyringBackend, o.keyringPassphrase)
 }
 
 func (b *Builder) fetch(ctx context.Context, o initOptions) (path, url, hash string, err error) {
@@ -0,0 +1,365 @@
+package networktest
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// launchQueryServer serves the queries of the launch module.
+type launchQueryServer struct {
+	launchtypes.UnimplementedQueryServer
+
+	n *LaunchNetwork
+}
+
+// launch returns the launch with launchID while the state is locked.
+func (s *launchQueryServer) launch(launchID uint64) (*launch, error) {
+	l, ok := s.n.state.launch(launchID)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "chain %d not found", launchID)
+	}
+	return l, nil
+}
+
+func (s *launchQueryServer) Chain(_ context.Context, req *launchtypes.QueryGetChainRequest) (
+	*launchtypes.QueryGetChainResponse, error) {
+	s.n.mu.Lock()
+	defer s.n.mu.Unlock()
+
+	l, err := s.launch(req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &launchtypes.QueryGetChainResponse{Chain: l.chain}, nil
+}
+
+func (s *launchQueryServer) ChainAll(context.Context, *launchtypes.QueryAllChainRequest) (
+	*launchtypes.QueryAllChainResponse, error) {
+	s.n.mu.Lock()
+	defer s.n.mu.Unlock()
+
+	res := &launchtypes.QueryAllChainResponse{}
+	for _, l := range s.n.state.launches {
+		res.Chain = append(res.Chain, l.chain)
+	}
+	return res, nil
+}
+
+func (s *launchQueryServer) GenesisAccountAll(_ context.Context, req *launchtypes.QueryAllGenesisAccountRequest) (
+	*launchtypes.QueryAllGenesisAccountResponse, error) {
+	s.n.mu.Lock()
+	defer s.n.mu.Unlock()
+
+	l, err := s.launch(req.ChainID)
+	if err != nil {
+		return nil, err
+	}
+	return &launchtypes.QueryAllGenesisAccountResponse{GenesisAccount: l.genesisAccounts}, nil
+}
+
+func (s *launchQueryServer) VestingAccountAll(_ context.Context, req *launchtypes.QueryAllVestingAccountRequest) (
+	*launchtypes.QueryAllVestingAccountResponse, error) {
+	s.n.mu.Lock()
+	defer s.n.mu.Unlock()
+
+	l, err := s.launch(req.ChainID)
+	if err != nil {
+		return nil, err
+	}
+	return &launchtypes.QueryAllVestingAccountResponse{VestingAccount: l.vestingAccounts}, nil
+}
+
+func (s *launchQueryServer) GenesisValidatorAll(_ context.Context, req *launchtypes.QueryAllGenesisValidatorRequest) (
+	*launchtypes.QueryAllGenesisValidatorResponse, error) {
+	s.n.mu.Lock()
+	defer s.n.mu.Unlock()
+
+	l, err := s.launch(req.ChainID)
+	if err != nil {
+		return nil, err
+	}
+	return &launchtypes.QueryAllGenesisValidatorResponse{GenesisValidator: l.genesisValidators}, nil
+}
+
+func (s *launchQueryServer) Request(_ context.Context, req *launchtypes.QueryGetRequestRequest) (
+	*launchtypes.QueryGetRequestResponse, error) {
+	s.n.mu.Lock()
+	defer s.n.mu.Unlock()
+
+	l, err := s.launch(req.ChainID)
+	if err != nil {
+		return nil, err
+	}
+	i, ok := l.requestIndex(req.RequestID)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "request %d not found", req.RequestID)
+	}
+	return &launchtypes.QueryGetRequestResponse{Request: l.requests[i]}, nil
+}
+
+func (s *launchQueryServer) RequestAll(_ context.Context, req *launchtypes.QueryAllRequestRequest) (
+	*launchtypes.QueryAllRequestResponse, error) {
+	s.n.mu.Lock()
+	defer s.n.mu.Unlock()
+
+	l, err := s.launch(req.ChainID)
+	if err != nil {
+		return nil, err
+	}
+	return &launchtypes.QueryAllRequestResponse{Request: l.requests}, nil
+}
+
+// launchMsgServer handles the msgs of the launch module. msgs are handled while the state is
+// locked by LaunchNetwork.BroadcastTx.
+type launchMsgServer struct {
+	launchtypes.UnimplementedMsgServer
+
+	n *LaunchNetwork
+}
+
+// pendingLaunch returns the launch with launchID if it is not triggered yet.
+func (s *launchMsgServer) pendingLaunch(launchID uint64) (*launch, error) {
+	l, ok := s.n.state.launch(launchID)
+	if !ok {
+		return nil, fmt.Errorf("chain %d not found", launchID)
+	}
+	if l.chain.LaunchTriggered {
+		return nil, fmt.Errorf("chain %d is already launched", launchID)
+	}
+	return l, nil
+}
+
+// coordinatedLaunch returns the pending launch with launchID if it is coordinated by coordinator.
+func (s *launchMsgServer) coordinatedLaunch(ctx context.Context, coordinator string, launchID uint64) (*launch, error) {
+	if err := checkSigner(ctx, coordinator); err != nil {
+		return nil, err
+	}
+	l, err := s.pendingLaunch(launchID)
+	if err != nil {
+		return nil, err
+	}
+	if c, ok := s.n.state.coordinatorByAddress(coordinator); !ok || c.CoordinatorId != l.chain.CoordinatorID {
+		return nil, fmt.Errorf("%s is not the coordinator of chain %d", coordinator, launchID)
+	}
+	return l, nil
+}
+
+// request adds a request with content to the pending launch with launchID.
+func (s *launchMsgServer) request(ctx context.Context, creator string, launchID uint64, content launchtypes.RequestContent) (
+	*launchtypes.MsgRequestResponse, error) {
+	if err := checkSigner(ctx, creator); err != nil {
+		return nil, err
+	}
+	l, err := s.pendingLaunch(launchID)
+	if err != nil {
+		return nil, err
+	}
+
+	id := l.requestCount
+	l.requestCount++
+	l.requests = append(l.requests, launchtypes.Request{
+		ChainID:   launchID,
+		RequestID: id,
+		Creator:   creator,
+		CreatedAt: s.n.now().Unix(),
+		Content:   content,
+	})
+
+	return &launchtypes.MsgRequestResponse{RequestID: id}, nil
+}
+
+func (s *launchMsgServer) CreateChain(ctx context.Context, msg *launchtypes.MsgCreateChain) (
+	*launchtypes.MsgCreateChainResponse, error) {
+	if err := checkSigner(ctx, msg.Coordinator); err != nil {
+		return nil, err
+	}
+	coordinator, ok := s.n.state.coordinatorByAddress(msg.Coordinator)
+	if !ok {
+		return nil, fmt.Errorf("coordinator %s not found", msg.Coordinator)
+	}
+
+	initialGenesis := launchtypes.NewDefaultInitialGenesis()
+	if msg.GenesisURL != "" {
+		initialGenesis = launchtypes.NewGenesisURL(msg.GenesisURL, msg.GenesisHash)
+	}
+
+	id := uint64(len(s.n.state.launches))
+	s.n.state.launches = append(s.n.state.launches, launch{
+		chain: launchtypes.Chain{
+			Id:             id,
+			CoordinatorID:  coordinator.CoordinatorId,
+			GenesisChainID: msg.GenesisChainID,
+			CreatedAt:      s.n.now().Unix(),
+			SourceURL:      msg.SourceURL,
+			SourceHash:     msg.SourceHash,
+			InitialGenesis: initialGenesis,
+			HasCampaign:    msg.HasCampaign,
+			CampaignID:     msg.CampaignID,
+		},
+	})
+
+	return &launchtypes.MsgCreateChainResponse{Id: id}, nil
+}
+
+func (s *launchMsgServer) RequestAddAccount(ctx context.Context, msg *launchtypes.MsgRequestAddAccount) (
+	*launchtypes.MsgRequestResponse, error) {
+	return s.request(ctx, msg.Address, msg.ChainID,
+		launchtypes.NewGenesisAccount(msg.ChainID, msg.Address, msg.Coins))
+}
+
+func (s *launchMsgServer) RequestAddVestingAccount(ctx context.Context, msg *launchtypes.MsgRequestAddVestingAccount) (
+	*launchtypes.MsgRequestResponse, error) {
+	return s.request(ctx, msg.Address, msg.ChainID,
+		launchtypes.NewVestingAccount(msg.ChainID, msg.Address, msg.StartingBalance, msg.Options))
+}
+
+func (s *launchMsgServer) RequestRemoveAccount(ctx context.Context, msg *launchtypes.MsgRequestRemoveAccount) (
+	*launchtypes.MsgRequestResponse, error) {
+	return s.request(ctx, msg.Creator, msg.ChainID, launchtypes.NewAccountRemoval(msg.Address))
+}
+
+func (s *launchMsgServer) RequestAddValidator(ctx context.Context, msg *launchtypes.MsgRequestAddValidator) (
+	*launchtypes.MsgRequestResponse, error) {
+	return s.request(ctx, msg.ValAddress, msg.ChainID, launchtypes.NewGenesisValidator(
+		msg.ChainID,
+		msg.ValAddress,
+		msg.GenTx,
+		msg.ConsPubKey,
+		msg.SelfDelegation,
+		msg.Peer,
+	))
+}
+
+func (s *launchMsgServer) RequestRemoveValidator(ctx context.Context, msg *launchtypes.MsgRequestRemoveValidator) (
+	*launchtypes.MsgRequestResponse, error) {
+	return s.request(ctx, msg.Creator, msg.ChainID, launchtypes.NewValidatorRemoval(msg.ValidatorAddress))
+}
+
+func (s *launchMsgServer) SettleRequest(ctx context.Context, msg *launchtypes.MsgSettleRequest) (
+	*launchtypes.MsgSettleRequestResponse, error) {
+	l, err := s.coordinatedLaunch(ctx, msg.Coordinator, msg.ChainID)
+	if err != nil {
+		return nil, err
+	}
+
+	i, ok := l.requestIndex(msg.RequestID)
+	if !ok {
+		return nil, fmt.Errorf("request %d of chain %d not found", msg.RequestID, msg.ChainID)
+	}
+
+	request := l.requests[i]
+	l.requests = append(l.requests[:i:i], l.requests[i+1:]...)
+
+	if msg.Approve {
+		if err := l.apply(request.Content); err != nil {
+			return nil, fmt.Errorf("request %d cannot be applied: %w", msg.RequestID, err)
+		}
+	}
+
+	return &launchtypes.MsgSettleRequestResponse{}, nil
+}
+
+func (s *launchMsgServer) TriggerLaunch(ctx context.Context, msg *launchtypes.MsgTriggerLaunch) (
+	*launchtypes.MsgTriggerLaunchResponse, error) {
+	l, err := s.coordinatedLaunch(ctx, msg.Coordinator, msg.ChainID)
+	if err != nil {
+		return nil, err
+	}
+
+	l.chain.LaunchTriggered = true
+	l.chain.LaunchTimestamp = s.n.now().Add(time.Duration(msg.RemainingTime) * time.Second).Unix()
+
+	return &launchtypes.MsgTriggerLaunchResponse{}, nil
+}
+
+// requestIndex returns the index of the request with requestID.
+func (l *launch) requestIndex(requestID uint64) (int, bool) {
+	for i, request := range l.requests {
+		if request.RequestID == requestID {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// apply applies the content of an approved request to the genesis information of the launch.
+func (l *launch) apply(content launchtypes.RequestContent) error {
+	switch c := content.Content.(type) {
+	case *launchtypes.RequestContent_GenesisAccount:
+		if l.hasAccount(c.GenesisAccount.Address) {
+			return fmt.Errorf("account %s already exists", c.GenesisAccount.Address)
+		}
+		l.genesisAccounts = append(l.genesisAccounts, *c.GenesisAccount)
+
+	case *launchtypes.RequestContent_VestingAccount:
+		if l.hasAccount(c.VestingAccount.Address) {
+			return fmt.Errorf("account %s already exists", c.VestingAccount.Address)
+		}
+		l.vestingAccounts = append(l.vestingAccounts, *c.VestingAccount)
+
+	case *launchtypes.RequestContent_GenesisValidator:
+		for _, validator := range l.genesisValidators {
+			if validator.Address == c.GenesisValidator.Address {
+				return fmt.Errorf("validator %s already exists", c.GenesisValidator.Address)
+			}
+		}
+		l.genesisValidators = append(l.genesisValidators, *c.GenesisValidator)
+
+	case *launchtypes.RequestContent_AccountRemoval:
+		address := c.AccountRemoval.Address
+		if !l.hasAccount(address) {
+			return fmt.Errorf("account %s not found", address)
+		}
+		var accounts []launchtypes.GenesisAccount
+		for _, account := range l.genesisAccounts {
+			if account.Address != address {
+				accounts = append(accounts, account)
+			}
+		}
+		var vestingAccounts []launchtypes.VestingAccount
+		for _, account := range l.vestingAccounts {
+			if account.Address != address {
+				vestingAccounts = append(vestingAccounts, account)
+			}
+		}
+		l.genesisAccounts, l.vestingAccounts = accounts, vestingAccounts
+
+	case *launchtypes.RequestContent_ValidatorRemoval:
+		address := c.ValidatorRemoval.ValAddress
+		var validators []launchtypes.GenesisValidator
+		for _, validator := range l.genesisValidators {
+			if validator.Address != address {
+				validators = append(validators, validator)
+			}
+		}
+		if len(validators) == len(l.genesisValidators) {
+			return fmt.Errorf("validator %s not found", address)
+		}
+		l.genesisValidators = validators
+
+	default:
+		return fmt.Errorf("unknown request content")
+	}
+
+	return nil
+}
+
+// hasAccount checks if there is a genesis or vesting account with address.
+func (l *launch) hasAccount(address string) bool {
+	for _, account := range l.genesisAccounts {
+		if account.Address == address {
+			return true
+		}
+	}
+	for _, account := range l.vestingAccounts {
+		if account.Address == address {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,132 @@
+// Package networktest provides an in-process launch network to test launching chains with
+// the network service without running a launch network node.
+package networktest
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+	profiletypes "github.com/tendermint/spn/x/profile/types"
+	"github.com/tendermint/starport/starport/pkg/cosmosaccount"
+	"github.com/tendermint/starport/starport/services/network"
+)
+
+// LaunchNetwork is an in-process launch network that implements the launch and profile modules
+// that are used to launch chains. it is a network.CosmosClient, so it can be given to network.New.
+//
+// txs are executed in memory as soon as they are broadcasted and they are either fully applied
+// or not applied at all, like on a real network. fees, gas and signatures are not checked but
+// msgs must be signed by the account that they are created for.
+type LaunchNetwork struct {
+	mu        sync.Mutex
+	registry  cosmosaccount.Registry
+	router    *router
+	now       func() time.Time
+	state     state
+	statePath string
+}
+
+// Option configures LaunchNetwork.
+type Option func(*LaunchNetwork)
+
+// WithClock sets the clock used for the creation and launch times, time.Now is used by default.
+func WithClock(now func() time.Time) Option {
+	return func(n *LaunchNetwork) {
+		n.now = now
+	}
+}
+
+// New creates a launch network that signs txs with the accounts in registry.
+func New(registry cosmosaccount.Registry, options ...Option) *LaunchNetwork {
+	n := &LaunchNetwork{
+		registry: registry,
+		router:   newRouter(),
+		now:      time.Now,
+	}
+
+	for _, apply := range options {
+		apply(n)
+	}
+
+	launchtypes.RegisterQueryServer(n.router, &launchQueryServer{n: n})
+	launchtypes.RegisterMsgServer(n.router, &launchMsgServer{n: n})
+	profiletypes.RegisterQueryServer(n.router, &profileQueryServer{n: n})
+	profiletypes.RegisterMsgServer(n.router, &profileMsgServer{n: n})
+
+	return n
+}
+
+// Open opens the launch network with the state saved at path, the state is created when it
+// doesn't exist. txs are saved to path once they are executed, so the launch network can be used
+// by different processes one after another, e.g. by the commands of the CLI.
+func Open(path string, registry cosmosaccount.Registry, options ...Option) (*LaunchNetwork, error) {
+	s, err := loadState(path)
+	if err != nil {
+		return nil, err
+	}
+
+	n := New(registry, options...)
+	n.state = s
+	n.statePath = path
+
+	return n, nil
+}
+
+// ClientConn returns a connection to query the launch network.
+func (n *LaunchNetwork) ClientConn() gogogrpc.ClientConn {
+	return n.router
+}
+
+// AccountRegistry returns the registry of the accounts that sign txs.
+func (n *LaunchNetwork) AccountRegistry() cosmosaccount.Registry {
+	return n.registry
+}
+
+// signerKey is the context key of the address that signs a tx.
+type signerKey struct{}
+
+// BroadcastTx executes msgs signed by the account named accountName in a single tx.
+func (n *LaunchNetwork) BroadcastTx(ctx context.Context, accountName string, msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+	account, err := n.registry.GetByName(accountName)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx = context.WithValue(ctx, signerKey{}, account.Address(network.SPNAddressPrefix))
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	// msgs are executed on a copy of the state that is committed only if all of them succeed.
+	committed := n.state
+	n.state = committed.clone()
+
+	for i, msg := range msgs {
+		if err := n.router.handleMsg(ctx, msg); err != nil {
+			n.state = committed
+			return nil, fmt.Errorf("msg %d failed: %w", i, err)
+		}
+	}
+
+	if n.statePath != "" {
+		if err := n.state.save(n.statePath); err != nil {
+			n.state = committed
+			return nil, err
+		}
+	}
+
+	return &sdk.TxResponse{}, nil
+}
+
+// checkSigner checks that the tx is signed by address.
+func checkSigner(ctx context.Context, address string) error {
+	if signer, _ := ctx.Value(signerKey{}).(string); signer != address {
+		return fmt.Errorf("msg of %s is signed by %s", address, signer)
+	}
+	return nil
+}
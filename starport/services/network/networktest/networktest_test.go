@@ -0,0 +1,86 @@
+package networktest_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+	profiletypes "github.com/tendermint/spn/x/profile/types"
+	"github.com/tendermint/starport/starport/pkg/cosmosaccount"
+	"github.com/tendermint/starport/starport/services/network"
+	"github.com/tendermint/starport/starport/services/network/networktest"
+)
+
+func TestOpen(t *testing.T) {
+	ctx := context.Background()
+	statePath := filepath.Join(t.TempDir(), "state.json")
+
+	registry, err := cosmosaccount.New(cosmosaccount.WithHome(t.TempDir()))
+	require.NoError(t, err)
+
+	coordinator, _, err := registry.Create("coordinator")
+	require.NoError(t, err)
+	validator, _, err := registry.Create("validator")
+	require.NoError(t, err)
+
+	var (
+		coordinatorAddress = coordinator.Address(network.SPNAddressPrefix)
+		validatorAddress   = validator.Address(network.SPNAddressPrefix)
+		coins              = sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	)
+
+	ln, err := networktest.Open(statePath, registry)
+	require.NoError(t, err)
+
+	_, err = ln.BroadcastTx(ctx, coordinator.Name,
+		profiletypes.NewMsgCreateCoordinator(coordinatorAddress, "", "", ""),
+		launchtypes.NewMsgCreateChain(coordinatorAddress, "mars-1", "https://mars.com", "abc", "", "", false, 0),
+	)
+	require.NoError(t, err)
+
+	_, err = ln.BroadcastTx(ctx, validator.Name,
+		launchtypes.NewMsgRequestAddAccount(validatorAddress, 0, coins),
+		launchtypes.NewMsgRequestAddValidator(validatorAddress, 0, []byte("gentx"), []byte("key"), coins[0], "node@1.2.3.4"),
+	)
+	require.NoError(t, err)
+
+	_, err = ln.BroadcastTx(ctx, coordinator.Name, launchtypes.NewMsgSettleRequest(coordinatorAddress, 0, 0, true))
+	require.NoError(t, err)
+
+	// failed txs are not saved.
+	_, err = ln.BroadcastTx(ctx, validator.Name, launchtypes.NewMsgSettleRequest(validatorAddress, 0, 1, true))
+	require.Error(t, err)
+
+	// the state is shared by the launch networks opened with the same file.
+	reopened, err := networktest.Open(statePath, registry)
+	require.NoError(t, err)
+
+	builder, err := network.New(reopened, coordinator)
+	require.NoError(t, err)
+
+	chain, err := builder.ChainLaunch(ctx, 0)
+	require.NoError(t, err)
+	require.Equal(t, "mars-1", chain.GenesisChainID)
+
+	accounts, err := builder.GenesisAccounts(ctx, 0)
+	require.NoError(t, err)
+	require.Len(t, accounts, 1)
+	require.Equal(t, validatorAddress, accounts[0].Address)
+
+	requests, err := builder.Requests(ctx, 0)
+	require.NoError(t, err)
+	require.Len(t, requests, 1)
+	require.Equal(t, uint64(1), requests[0].RequestID)
+
+	// request ids keep increasing after the state is loaded.
+	_, err = reopened.BroadcastTx(ctx, validator.Name, launchtypes.NewMsgRequestAddAccount(validatorAddress, 0, coins))
+	require.NoError(t, err)
+
+	requests, err = builder.Requests(ctx, 0)
+	require.NoError(t, err)
+	require.Len(t, requests, 2)
+	require.Equal(t, uint64(2), requests[1].RequestID)
+}
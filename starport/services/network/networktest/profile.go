@@ -0,0 +1,71 @@
+package networktest
+
+import (
+	"context"
+	"fmt"
+
+	profiletypes "github.com/tendermint/spn/x/profile/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// profileQueryServer serves the queries of the profile module that are used by coordinators.
+type profileQueryServer struct {
+	profiletypes.UnimplementedQueryServer
+
+	n *LaunchNetwork
+}
+
+func (s *profileQueryServer) Coordinator(_ context.Context, req *profiletypes.QueryGetCoordinatorRequest) (
+	*profiletypes.QueryGetCoordinatorResponse, error) {
+	s.n.mu.Lock()
+	defer s.n.mu.Unlock()
+
+	if req.Id >= uint64(len(s.n.state.coordinators)) {
+		return nil, status.Errorf(codes.NotFound, "coordinator %d not found", req.Id)
+	}
+	return &profiletypes.QueryGetCoordinatorResponse{Coordinator: s.n.state.coordinators[req.Id]}, nil
+}
+
+func (s *profileQueryServer) CoordinatorByAddress(_ context.Context, req *profiletypes.QueryGetCoordinatorByAddressRequest) (
+	*profiletypes.QueryGetCoordinatorByAddressResponse, error) {
+	s.n.mu.Lock()
+	defer s.n.mu.Unlock()
+
+	coordinator, ok := s.n.state.coordinatorByAddress(req.Address)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "coordinator %s not found", req.Address)
+	}
+	return &profiletypes.QueryGetCoordinatorByAddressResponse{
+		CoordinatorByAddress: profiletypes.CoordinatorByAddress{
+			Address:       coordinator.Address,
+			CoordinatorId: coordinator.CoordinatorId,
+		},
+	}, nil
+}
+
+// profileMsgServer handles the msgs of the profile module that are used by coordinators.
+type profileMsgServer struct {
+	profiletypes.UnimplementedMsgServer
+
+	n *LaunchNetwork
+}
+
+func (s *profileMsgServer) CreateCoordinator(ctx context.Context, msg *profiletypes.MsgCreateCoordinator) (
+	*profiletypes.MsgCreateCoordinatorResponse, error) {
+	if err := checkSigner(ctx, msg.Address); err != nil {
+		return nil, err
+	}
+	if _, ok := s.n.state.coordinatorByAddress(msg.Address); ok {
+		return nil, fmt.Errorf("coordinator %s already exists", msg.Address)
+	}
+
+	id := uint64(len(s.n.state.coordinators))
+	s.n.state.coordinators = append(s.n.state.coordinators, profiletypes.Coordinator{
+		CoordinatorId: id,
+		Address:       msg.Address,
+		Description:   msg.Description,
+	})
+
+	return &profiletypes.MsgCreateCoordinatorResponse{CoordinatorId: id}, nil
+}
@@ -0,0 +1,92 @@
+package networktest
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/gogo/protobuf/proto"
+	"google.golang.org/grpc"
+)
+
+// handler is a registered gRPC method with its server.
+type handler struct {
+	srv    interface{}
+	method grpc.MethodDesc
+}
+
+// router serves gRPC services in-process. it implements the gogo gRPC server interface to register
+// services with their generated Register functions and the client conn interface to call them.
+type router struct {
+	handlers map[string]handler
+}
+
+func newRouter() *router {
+	return &router{handlers: make(map[string]handler)}
+}
+
+// RegisterService registers the methods of a service implemented by srv.
+func (r *router) RegisterService(sd *grpc.ServiceDesc, srv interface{}) {
+	for _, method := range sd.Methods {
+		r.handlers[fmt.Sprintf("/%s/%s", sd.ServiceName, method.MethodName)] = handler{srv, method}
+	}
+}
+
+// Invoke calls the registered method with args and sets its response to reply.
+func (r *router) Invoke(ctx context.Context, method string, args, reply interface{}, _ ...grpc.CallOption) error {
+	res, err := r.call(ctx, method, args)
+	if err != nil {
+		return err
+	}
+	return copyMessage(res, reply)
+}
+
+// NewStream is not supported since launch network services have no streaming methods.
+func (r *router) NewStream(context.Context, *grpc.StreamDesc, string, ...grpc.CallOption) (grpc.ClientStream, error) {
+	return nil, fmt.Errorf("streaming is not supported")
+}
+
+// handleMsg calls the method of the Msg service that handles msg.
+func (r *router) handleMsg(ctx context.Context, msg proto.Message) error {
+	// msgs are named after the methods handling them, e.g. tendermint.spn.launch.MsgCreateChain
+	// is handled by /tendermint.spn.launch.Msg/CreateChain.
+	name := proto.MessageName(msg)
+	i := strings.LastIndex(name, ".")
+	method := fmt.Sprintf("/%s.Msg/%s", name[:i], strings.TrimPrefix(name[i+1:], "Msg"))
+
+	_, err := r.call(ctx, method, msg)
+	return err
+}
+
+func (r *router) call(ctx context.Context, method string, args interface{}) (interface{}, error) {
+	h, ok := r.handlers[method]
+	if !ok {
+		return nil, fmt.Errorf("unknown method %s", method)
+	}
+
+	dec := func(v interface{}) error {
+		return copyMessage(args, v)
+	}
+
+	return h.method.Handler(h.srv, ctx, dec, nil)
+}
+
+// copyMessage copies the proto message src to dst, so servers and clients don't share memory.
+func copyMessage(src, dst interface{}) error {
+	srcMsg, ok := src.(codec.ProtoMarshaler)
+	if !ok {
+		return fmt.Errorf("%T is not a proto message", src)
+	}
+	dstMsg, ok := dst.(codec.ProtoMarshaler)
+	if !ok {
+		return fmt.Errorf("%T is not a proto message", dst)
+	}
+
+	data, err := srcMsg.Marshal()
+	if err != nil {
+		return err
+	}
+	dstMsg.Reset()
+	return dstMsg.Unmarshal(data)
+}
@@ -0,0 +1,217 @@
+package networktest
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+	profiletypes "github.com/tendermint/spn/x/profile/types"
+)
+
+// state is the state of the launch network.
+type state struct {
+	// coordinators are indexed by their ids.
+	coordinators []profiletypes.Coordinator
+
+	// launches are indexed by the launch ids of their chains.
+	launches []launch
+}
+
+// launch is the state of a chain being launched.
+type launch struct {
+	chain             launchtypes.Chain
+	requests          []launchtypes.Request
+	requestCount      uint64
+	genesisAccounts   []launchtypes.GenesisAccount
+	vestingAccounts   []launchtypes.VestingAccount
+	genesisValidators []launchtypes.GenesisValidator
+}
+
+// clone returns a copy of the state that can be modified without changing s.
+func (s state) clone() state {
+	c := state{
+		coordinators: append([]profiletypes.Coordinator(nil), s.coordinators...),
+		launches:     make([]launch, len(s.launches)),
+	}
+
+	for i, l := range s.launches {
+		c.launches[i] = launch{
+			chain:             l.chain,
+			requests:          append([]launchtypes.Request(nil), l.requests...),
+			requestCount:      l.requestCount,
+			genesisAccounts:   append([]launchtypes.GenesisAccount(nil), l.genesisAccounts...),
+			vestingAccounts:   append([]launchtypes.VestingAccount(nil), l.vestingAccounts...),
+			genesisValidators: append([]launchtypes.GenesisValidator(nil), l.genesisValidators...),
+		}
+	}
+
+	return c
+}
+
+// coordinatorByAddress returns the coordinator with address.
+func (s state) coordinatorByAddress(address string) (profiletypes.Coordinator, bool) {
+	for _, coordinator := range s.coordinators {
+		if coordinator.Address == address {
+			return coordinator, true
+		}
+	}
+	return profiletypes.Coordinator{}, false
+}
+
+// launch returns the launch with launchID.
+func (s state) launch(launchID uint64) (*launch, bool) {
+	if launchID >= uint64(len(s.launches)) {
+		return nil, false
+	}
+	return &s.launches[launchID], true
+}
+
+// stateFile is the content of a state file, the state is kept as the protobuf encoded
+// genesis states of the launch and profile modules.
+type stateFile struct {
+	Launch  []byte `json:"launch"`
+	Profile []byte `json:"profile"`
+}
+
+// loadState loads the state saved at path, the state is empty when there is no file at path.
+func loadState(path string) (state, error) {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return state{}, nil
+	}
+	if err != nil {
+		return state{}, err
+	}
+
+	var (
+		file           stateFile
+		launchGenesis  launchtypes.GenesisState
+		profileGenesis profiletypes.GenesisState
+	)
+	if err := json.Unmarshal(data, &file); err != nil {
+		return state{}, fmt.Errorf("invalid state file %s: %w", path, err)
+	}
+	if err := launchGenesis.Unmarshal(file.Launch); err != nil {
+		return state{}, fmt.Errorf("invalid launch state in %s: %w", path, err)
+	}
+	if err := profileGenesis.Unmarshal(file.Profile); err != nil {
+		return state{}, fmt.Errorf("invalid profile state in %s: %w", path, err)
+	}
+
+	return stateFromGenesis(launchGenesis, profileGenesis)
+}
+
+// save saves s to the file at path.
+func (s state) save(path string) error {
+	launchGenesis, profileGenesis := s.genesis()
+
+	var (
+		file stateFile
+		err  error
+	)
+	if file.Launch, err = launchGenesis.Marshal(); err != nil {
+		return err
+	}
+	if file.Profile, err = profileGenesis.Marshal(); err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(file)
+	if err != nil {
+		return err
+	}
+
+	// the state is replaced at once, so it is never left partially written.
+	tmpPath := path + ".tmp"
+	if err := os.WriteFile(tmpPath, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmpPath, path)
+}
+
+// genesis returns the genesis states of the launch and profile modules with the state.
+func (s state) genesis() (launchtypes.GenesisState, profiletypes.GenesisState) {
+	launchGenesis := launchtypes.GenesisState{ChainCount: uint64(len(s.launches))}
+	for _, l := range s.launches {
+		launchGenesis.ChainList = append(launchGenesis.ChainList, l.chain)
+		launchGenesis.RequestList = append(launchGenesis.RequestList, l.requests...)
+		launchGenesis.RequestCountList = append(launchGenesis.RequestCountList, launchtypes.RequestCount{
+			ChainID: l.chain.Id,
+			Count:   l.requestCount,
+		})
+		launchGenesis.GenesisAccountList = append(launchGenesis.GenesisAccountList, l.genesisAccounts...)
+		launchGenesis.VestingAccountList = append(launchGenesis.VestingAccountList, l.vestingAccounts...)
+		launchGenesis.GenesisValidatorList = append(launchGenesis.GenesisValidatorList, l.genesisValidators...)
+	}
+
+	profileGenesis := profiletypes.GenesisState{
+		CoordinatorList:  s.coordinators,
+		CoordinatorCount: uint64(len(s.coordinators)),
+	}
+
+	return launchGenesis, profileGenesis
+}
+
+// stateFromGenesis creates a state from the genesis states of the launch and profile modules.
+func stateFromGenesis(launchGenesis launchtypes.GenesisState, profileGenesis profiletypes.GenesisState) (state, error) {
+	s := state{
+		coordinators: profileGenesis.CoordinatorList,
+		launches:     make([]launch, len(launchGenesis.ChainList)),
+	}
+
+	// launches are indexed by the launch ids of their chains.
+	for _, chain := range launchGenesis.ChainList {
+		l, ok := s.launch(chain.Id)
+		if !ok {
+			return state{}, fmt.Errorf("invalid launch id %d", chain.Id)
+		}
+		l.chain = chain
+	}
+
+	launchOf := func(launchID uint64) (*launch, error) {
+		l, ok := s.launch(launchID)
+		if !ok {
+			return nil, fmt.Errorf("chain %d not found", launchID)
+		}
+		return l, nil
+	}
+
+	for _, request := range launchGenesis.RequestList {
+		l, err := launchOf(request.ChainID)
+		if err != nil {
+			return state{}, err
+		}
+		l.requests = append(l.requests, request)
+	}
+	for _, count := range launchGenesis.RequestCountList {
+		l, err := launchOf(count.ChainID)
+		if err != nil {
+			return state{}, err
+		}
+		l.requestCount = count.Count
+	}
+	for _, account := range launchGenesis.GenesisAccountList {
+		l, err := launchOf(account.ChainID)
+		if err != nil {
+			return state{}, err
+		}
+		l.genesisAccounts = append(l.genesisAccounts, account)
+	}
+	for _, account := range launchGenesis.VestingAccountList {
+		l, err := launchOf(account.ChainID)
+		if err != nil {
+			return state{}, err
+		}
+		l.vestingAccounts = append(l.vestingAccounts, account)
+	}
+	for _, validator := range launchGenesis.GenesisValidatorList {
+		l, err := launchOf(validator.ChainID)
+		if err != nil {
+			return state{}, err
+		}
+		l.genesisValidators = append(l.genesisValidators, validator)
+	}
+
+	return s, nil
+}
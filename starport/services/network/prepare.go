@@ -0,0 +1,311 @@
+package network
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+	"github.com/tendermint/starport/starport/pkg/chaincmd"
+	"github.com/tendermint/starport/starport/pkg/confile"
+	"github.com/tendermint/starport/starport/pkg/events"
+	"github.com/tendermint/starport/starport/services/chain"
+)
+
+// prepareOptions holds info about how to prepare the genesis of a chain.
+type prepareOptions struct {
+	genesisHash string
+}
+
+// PrepareOption configures preparing the genesis of a chain.
+type PrepareOption func(*prepareOptions)
+
+// WithExpectedGenesisHash makes preparing fail when the hash of the prepared genesis is not hash,
+// e.g. the hash shared by the coordinator of the chain.
+func WithExpectedGenesisHash(hash string) PrepareOption {
+	return func(o *prepareOptions) {
+		o.genesisHash = hash
+	}
+}
+
+// Prepare prepares the genesis of the chain with launchID by applying its approved genesis accounts,
+// vesting accounts and gentxs to its published genesis. they are applied in the order of their
+// addresses, so every validator ends up with the same genesis. keys of the node are kept when the
+// chain is already initialized. the hash of the prepared genesis is returned.
+// accounts are requested with their SPN addresses, they are added to the genesis with the
+// address prefix of the chain. only delayed vesting accounts are supported.
+func (b *Blockchain) Prepare(ctx context.Context, launchID uint64, options ...PrepareOption) (genesisHash string, err error) {
+	var o prepareOptions
+	for _, apply := range options {
+		apply(&o)
+	}
+
+	chainLaunch, err := b.builder.ChainLaunch(ctx, launchID)
+	if err != nil {
+		return "", err
+	}
+
+	b.builder.ev.Send(events.New(events.StatusOngoing, "Fetching approved requests"))
+
+	accounts, err := b.builder.GenesisAccounts(ctx, launchID)
+	if err != nil {
+		return "", err
+	}
+	vestingAccounts, err := b.builder.VestingAccounts(ctx, launchID)
+	if err != nil {
+		return "", err
+	}
+	validators, err := b.builder.GenesisValidators(ctx, launchID)
+	if err != nil {
+		return "", err
+	}
+
+	sortByAddress(accounts, vestingAccounts, validators)
+
+	b.builder.ev.Send(events.New(events.StatusDone, "Approved requests fetched"))
+
+	exists, err := b.IsHomeDirExist()
+	if err != nil {
+		return "", err
+	}
+	if exists {
+		if _, err := b.chain.Build(ctx, ""); err != nil {
+			return "", err
+		}
+	} else if err := b.Init(ctx); err != nil {
+		return "", err
+	}
+
+	b.builder.ev.Send(events.New(events.StatusOngoing, "Preparing the genesis"))
+
+	if genesisURL := chainLaunch.InitialGenesis.GetGenesisURL(); genesisURL != nil {
+		err = b.initGenesisFromURL(ctx, genesisURL.Url, genesisURL.Hash)
+	} else {
+		err = b.initDefaultGenesis(ctx)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	if err := b.setGenesisTime(genesisTime(chainLaunch)); err != nil {
+		return "", err
+	}
+
+	prefix, err := b.addressPrefix(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	if err := toChainAddresses(prefix, accounts, vestingAccounts); err != nil {
+		return "", err
+	}
+
+	commands, err := b.chain.Commands(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	for _, account := range accounts {
+		if err := commands.AddGenesisAccount(ctx, account.Address, account.Coins.String()); err != nil {
+			return "", err
+		}
+	}
+
+	for _, account := range vestingAccounts {
+		delayed := account.VestingOptions.GetDelayedVesting()
+		if delayed == nil {
+			return "", fmt.Errorf("vesting options of account %s are not supported", account.Address)
+		}
+
+		coins := account.StartingBalance.Add(delayed.Vesting...)
+
+		if err := commands.AddVestingAccount(
+			ctx,
+			account.Address,
+			coins.String(),
+			delayed.Vesting.String(),
+			delayed.EndTime,
+		); err != nil {
+			return "", err
+		}
+	}
+
+	if err := b.writeGentxs(validators); err != nil {
+		return "", err
+	}
+
+	if len(validators) > 0 {
+		if err := commands.CollectGentxs(ctx); err != nil {
+			return "", err
+		}
+	}
+
+	if err := commands.ValidateGenesis(ctx); err != nil {
+		return "", err
+	}
+
+	genesisPath, err := b.chain.GenesisPath()
+	if err != nil {
+		return "", err
+	}
+
+	genesis, err := os.ReadFile(genesisPath)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(genesis)
+	genesisHash = hex.EncodeToString(sum[:])
+
+	if o.genesisHash != "" && o.genesisHash != genesisHash {
+		return "", fmt.Errorf("hash of the prepared genesis %s doesn't match with the expected %s", genesisHash, o.genesisHash)
+	}
+
+	b.builder.ev.Send(events.New(events.StatusDone, "Genesis prepared"))
+
+	return genesisHash, nil
+}
+
+// initDefaultGenesis replaces the genesis of the chain with the default one generated by the chain's
+// init command. it is generated in a temporary home to not overwrite the keys of the node.
+func (b *Blockchain) initDefaultGenesis(ctx context.Context) error {
+	tmpHome, err := os.MkdirTemp("", "")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmpHome)
+
+	c, err := b.tmpChain(tmpHome)
+	if err != nil {
+		return err
+	}
+
+	if err := c.InitChain(ctx); err != nil {
+		return err
+	}
+
+	tmpGenesisPath, err := c.GenesisPath()
+	if err != nil {
+		return err
+	}
+
+	genesis, err := os.ReadFile(tmpGenesisPath)
+	if err != nil {
+		return err
+	}
+
+	genesisPath, err := b.chain.GenesisPath()
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(genesisPath, genesis, 0644)
+}
+
+// tmpChain returns the chain with its home at tmpHome and a test keyring, so it can be used
+// without changing the node's home.
+func (b *Blockchain) tmpChain(tmpHome string) (*chain.Chain, error) {
+	chainID, err := b.chain.ID()
+	if err != nil {
+		return nil, err
+	}
+
+	return chain.New(b.appPath,
+		chain.LogLevel(chain.LogSilent),
+		chain.ID(chainID),
+		chain.HomePath(tmpHome),
+		chain.KeyringBackend(chaincmd.KeyringBackendTest),
+	)
+}
+
+// setGenesisTime sets the genesis time of the chain's genesis.
+func (b *Blockchain) setGenesisTime(t time.Time) error {
+	genesisPath, err := b.chain.GenesisPath()
+	if err != nil {
+		return err
+	}
+
+	cf := confile.New(confile.DefaultJSONEncodingCreator, genesisPath)
+
+	var genesis map[string]interface{}
+	if err := cf.Load(&genesis); err != nil {
+		return err
+	}
+
+	genesis["genesis_time"] = t.UTC().Format(time.RFC3339Nano)
+
+	return cf.Save(genesis)
+}
+
+// writeGentxs replaces the gentxs in the chain's gentx dir with the gentxs of validators.
+func (b *Blockchain) writeGentxs(validators []launchtypes.GenesisValidator) error {
+	home, err := b.chain.Home()
+	if err != nil {
+		return err
+	}
+
+	gentxDir := filepath.Join(home, "config/gentx")
+
+	if err := os.RemoveAll(gentxDir); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(gentxDir, 0755); err != nil {
+		return err
+	}
+
+	for _, validator := range validators {
+		path := filepath.Join(gentxDir, fmt.Sprintf("gentx-%s.json", validator.Address))
+		if err := os.WriteFile(path, validator.GenTx, 0644); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// genesisTime returns the genesis time of the chain, which is its launch time once the launch
+// is triggered, so genesis prepared by different validators is the same.
+func genesisTime(chain launchtypes.Chain) time.Time {
+	if chain.LaunchTriggered {
+		return time.Unix(chain.LaunchTimestamp, 0)
+	}
+	return time.Unix(chain.CreatedAt, 0)
+}
+
+// toChainAddresses converts the SPN addresses of the accounts to the addresses of the chain with prefix.
+func toChainAddresses(prefix string, accounts []launchtypes.GenesisAccount, vestingAccounts []launchtypes.VestingAccount) error {
+	var err error
+	for i := range accounts {
+		if accounts[i].Address, err = chainAddress(accounts[i].Address, prefix); err != nil {
+			return err
+		}
+	}
+	for i := range vestingAccounts {
+		if vestingAccounts[i].Address, err = chainAddress(vestingAccounts[i].Address, prefix); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// sortByAddress sorts the approved genesis information to apply it in a canonical order.
+func sortByAddress(
+	accounts []launchtypes.GenesisAccount,
+	vestingAccounts []launchtypes.VestingAccount,
+	validators []launchtypes.GenesisValidator,
+) {
+	sort.Slice(accounts, func(i, j int) bool {
+		return accounts[i].Address < accounts[j].Address
+	})
+	sort.Slice(vestingAccounts, func(i, j int) bool {
+		return vestingAccounts[i].Address < vestingAccounts[j].Address
+	})
+	sort.Slice(validators, func(i, j int) bool {
+		return validators[i].Address < validators[j].Address
+	})
+}
@@ -0,0 +1,49 @@
+package network
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/stretchr/testify/require"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+)
+
+func TestSortByAddress(t *testing.T) {
+	accounts := []launchtypes.GenesisAccount{{Address: "spn1c"}, {Address: "spn1a"}, {Address: "spn1b"}}
+	vestingAccounts := []launchtypes.VestingAccount{{Address: "spn1b"}, {Address: "spn1a"}}
+	validators := []launchtypes.GenesisValidator{{Address: "spn1z"}, {Address: "spn1y"}}
+
+	sortByAddress(accounts, vestingAccounts, validators)
+
+	require.Equal(t, []launchtypes.GenesisAccount{{Address: "spn1a"}, {Address: "spn1b"}, {Address: "spn1c"}}, accounts)
+	require.Equal(t, []launchtypes.VestingAccount{{Address: "spn1a"}, {Address: "spn1b"}}, vestingAccounts)
+	require.Equal(t, []launchtypes.GenesisValidator{{Address: "spn1y"}, {Address: "spn1z"}}, validators)
+}
+
+func TestGenesisTime(t *testing.T) {
+	chain := launchtypes.Chain{CreatedAt: 100}
+	require.Equal(t, time.Unix(100, 0), genesisTime(chain))
+
+	chain.LaunchTriggered = true
+	chain.LaunchTimestamp = 200
+	require.Equal(t, time.Unix(200, 0), genesisTime(chain))
+}
+
+func TestToChainAddresses(t *testing.T) {
+	address := func(prefix, addr string) string {
+		address, err := bech32.ConvertAndEncode(prefix, []byte(addr))
+		require.NoError(t, err)
+		return address
+	}
+
+	accounts := []launchtypes.GenesisAccount{{Address: address(SPNAddressPrefix, "account")}}
+	vestingAccounts := []launchtypes.VestingAccount{{Address: address(SPNAddressPrefix, "vesting")}}
+
+	require.NoError(t, toChainAddresses("mars", accounts, vestingAccounts))
+	require.Equal(t, address("mars", "account"), accounts[0].Address)
+	require.Equal(t, address("mars", "vesting"), vestingAccounts[0].Address)
+
+	accounts = []launchtypes.GenesisAccount{{Address: "spn1invalid"}}
+	require.Error(t, toChainAddresses("mars", accounts, nil))
+}
@@ -0,0 +1,104 @@
+package network
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+	profiletypes "github.com/tendermint/spn/x/profile/types"
+	"github.com/tendermint/starport/starport/pkg/events"
+)
+
+// Requests returns the pending requests of the chain with launchID.
+func (b *Builder) Requests(ctx context.Context, launchID uint64) ([]launchtypes.Request, error) {
+	var requests []launchtypes.Request
+
+	err := fetchPages(func(pagination *query.PageRequest) (*query.PageResponse, error) {
+		res, err := launchtypes.NewQueryClient(b.cosmos.ClientConn()).RequestAll(ctx, &launchtypes.QueryAllRequestRequest{
+			ChainID:    launchID,
+			Pagination: pagination,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		requests = append(requests, res.Request...)
+
+		return res.Pagination, nil
+	})
+
+	return requests, err
+}
+
+// ApproveRequests approves the requests with requestIDs of the chain with launchID in a single tx.
+func (b *Builder) ApproveRequests(ctx context.Context, launchID uint64, requestIDs ...uint64) error {
+	return b.settleRequests(ctx, launchID, true, requestIDs)
+}
+
+// RejectRequests rejects the requests with requestIDs of the chain with launchID in a single tx.
+func (b *Builder) RejectRequests(ctx context.Context, launchID uint64, requestIDs ...uint64) error {
+	return b.settleRequests(ctx, launchID, false, requestIDs)
+}
+
+// settleRequests approves or rejects requests after making sure that the account of the builder
+// coordinates the chain, so the whole batch doesn't fail on SPN because of a single request.
+func (b *Builder) settleRequests(ctx context.Context, launchID uint64, approve bool, requestIDs []uint64) error {
+	if len(requestIDs) == 0 {
+		return fmt.Errorf("no requests to settle")
+	}
+
+	b.ev.Send(events.New(events.StatusOngoing, "Verifying the requests"))
+
+	chain, err := b.ChainLaunch(ctx, launchID)
+	if err != nil {
+		return err
+	}
+	if chain.LaunchTriggered {
+		return fmt.Errorf("chain %d is already launched", launchID)
+	}
+
+	address := b.account.Address(SPNAddressPrefix)
+
+	res, err := profiletypes.NewQueryClient(b.cosmos.ClientConn()).CoordinatorByAddress(ctx, &profiletypes.QueryGetCoordinatorByAddressRequest{
+		Address: address,
+	})
+	if err != nil || res.CoordinatorByAddress.CoordinatorId != chain.CoordinatorID {
+		return fmt.Errorf("account %q is not the coordinator of chain %d", b.account.Name, launchID)
+	}
+
+	requests, err := b.Requests(ctx, launchID)
+	if err != nil {
+		return err
+	}
+
+	pending := make(map[uint64]bool)
+	for _, request := range requests {
+		pending[request.RequestID] = true
+	}
+
+	var msgs []sdk.Msg
+	for _, id := range requestIDs {
+		if !pending[id] {
+			return fmt.Errorf("request %d of chain %d is not pending", id, launchID)
+		}
+		msgs = append(msgs, launchtypes.NewMsgSettleRequest(address, launchID, id, approve))
+	}
+
+	b.ev.Send(events.New(events.StatusDone, "Requests verified"))
+
+	action := "Rejecting"
+	if approve {
+		action = "Approving"
+	}
+	b.ev.Send(events.New(events.StatusOngoing, fmt.Sprintf("%s %d request(s)", action, len(msgs))))
+
+	if _, err := b.cosmos.BroadcastTx(ctx, b.account.Name, msgs...); err != nil {
+		return err
+	}
+
+	b.ev.Send(events.New(events.StatusDone, "Requests settled"))
+
+	return nil
+}
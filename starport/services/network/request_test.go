@@ -0,0 +1,80 @@
+package network_test
+
+import (
+	"context"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	launchtypes "github.com/tendermint/spn/x/launch/types"
+	profiletypes "github.com/tendermint/spn/x/profile/types"
+	"github.com/tendermint/starport/starport/pkg/cosmosaccount"
+	"github.com/tendermint/starport/starport/services/network"
+	"github.com/tendermint/starport/starport/services/network/networktest"
+)
+
+func TestSettleRequests(t *testing.T) {
+	ctx := context.Background()
+
+	registry, err := cosmosaccount.New(cosmosaccount.WithHome(t.TempDir()))
+	require.NoError(t, err)
+
+	coordinator, _, err := registry.Create("coordinator")
+	require.NoError(t, err)
+	validator, _, err := registry.Create("validator")
+	require.NoError(t, err)
+
+	var (
+		ln                 = networktest.New(registry)
+		coordinatorAddress = coordinator.Address(network.SPNAddressPrefix)
+		validatorAddress   = validator.Address(network.SPNAddressPrefix)
+		coins              = sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	)
+
+	_, err = ln.BroadcastTx(ctx, coordinator.Name,
+		profiletypes.NewMsgCreateCoordinator(coordinatorAddress, "", "", ""),
+		launchtypes.NewMsgCreateChain(coordinatorAddress, "mars-1", "https://mars.com", "abc", "", "", false, 0),
+	)
+	require.NoError(t, err)
+
+	_, err = ln.BroadcastTx(ctx, validator.Name,
+		launchtypes.NewMsgRequestAddAccount(validatorAddress, 0, coins),
+		launchtypes.NewMsgRequestAddValidator(validatorAddress, 0, []byte("gentx"), []byte("key"), coins[0], "node@1.2.3.4"),
+	)
+	require.NoError(t, err)
+
+	_, err = ln.BroadcastTx(ctx, validator.Name, launchtypes.NewMsgRequestAddAccount(coordinatorAddress, 0, coins))
+	require.Error(t, err, "msgs must be signed by their creators")
+
+	coordinatorBuilder, err := network.New(ln, coordinator)
+	require.NoError(t, err)
+	validatorBuilder, err := network.New(ln, validator)
+	require.NoError(t, err)
+
+	chains, err := validatorBuilder.ChainLaunches(ctx)
+	require.NoError(t, err)
+	require.Len(t, chains, 1)
+	require.Equal(t, "mars-1", chains[0].GenesisChainID)
+
+	requests, err := coordinatorBuilder.Requests(ctx, 0)
+	require.NoError(t, err)
+	require.Len(t, requests, 2)
+
+	require.Error(t, validatorBuilder.ApproveRequests(ctx, 0, 0, 1))
+	require.Error(t, coordinatorBuilder.ApproveRequests(ctx, 0, 0, 2))
+
+	require.NoError(t, coordinatorBuilder.ApproveRequests(ctx, 0, 0))
+	require.NoError(t, coordinatorBuilder.RejectRequests(ctx, 0, 1))
+
+	requests, err = coordinatorBuilder.Requests(ctx, 0)
+	require.NoError(t, err)
+	require.Empty(t, requests)
+
+	accounts, err := coordinatorBuilder.GenesisAccounts(ctx, 0)
+	require.NoError(t, err)
+	require.Equal(t, []launchtypes.GenesisAccount{{ChainID: 0, Address: validatorAddress, Coins: coins}}, accounts)
+
+	validators, err := coordinatorBuilder.GenesisValidators(ctx, 0)
+	require.NoError(t, err)
+	require.Empty(t, validators)
+}
@@ -13,6 +13,7 @@ import (
 
 	"github.com/tendermint/starport/starport/pkg/multiformatname"
 	"github.com/tendermint/starport/starport/pkg/protoanalysis"
+	"github.com/tendermint/starport/starport/templates/field"
 	"github.com/tendermint/starport/starport/templates/field/datatype"
 )
 
@@ -218,7 +219,8 @@ func checkCustomTypes(ctx context.Context, path, module string, fields []string)
 		}
 		fieldType := datatype.Name(fieldSplit[1])
 		if _, ok := datatype.SupportedTypes[fieldType]; !ok {
-			customFields = append(customFields, string(fieldType))
+			customType, _ := field.CustomType(fieldType)
+			customFields = append(customFields, customType)
 		}
 	}
 	return protoanalysis.HasMessages(ctx, protoPath, customFields...)
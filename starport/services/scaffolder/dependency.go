@@ -0,0 +1,51 @@
+package scaffolder
+
+import (
+	"context"
+	"fmt"
+	"go/types"
+	"path/filepath"
+
+	appanalysis "github.com/tendermint/starport/starport/pkg/cosmosanalysis/app"
+	"github.com/tendermint/starport/starport/pkg/goanalysis"
+	"github.com/tendermint/starport/starport/templates/module"
+	modulecreate "github.com/tendermint/starport/starport/templates/module/create"
+)
+
+// resolveDependencyMethods finds the methods imported from each dependency in the type of its keeper
+// and adds them to the expected keeper of the dependency
+func resolveDependencyMethods(ctx context.Context, dependencies []modulecreate.Dependency, appPath string) error {
+	for i := range dependencies {
+		dep := &dependencies[i]
+		if len(dep.Methods) == 0 {
+			continue
+		}
+
+		keeperType, err := appanalysis.FindKeeperType(filepath.Join(appPath, module.PathAppModule), dep.KeeperName)
+		if err != nil {
+			return fmt.Errorf("the methods of %s can't be imported: %s", dep.Name, err.Error())
+		}
+		methods, err := goanalysis.TypeMethods(ctx, appPath, keeperType.PkgPath, keeperType.Name, keeperType.Pointer)
+		if err != nil {
+			return fmt.Errorf("the methods of %s can't be imported: %s", dep.Name, err.Error())
+		}
+
+		methodMap := make(map[string]*types.Func)
+		for _, method := range methods {
+			methodMap[method.Name()] = method
+		}
+		added := make(map[string]struct{})
+		for _, name := range dep.Methods {
+			method, ok := methodMap[name]
+			if !ok {
+				return fmt.Errorf("%s.%s doesn't have the method %s", keeperType.PkgPath, keeperType.Name, name)
+			}
+			if _, ok := added[name]; ok {
+				return fmt.Errorf("%s is a duplicated method of %s", name, dep.Name)
+			}
+			added[name] = struct{}{}
+			dep.AddKeeperMethod(modulecreate.NewKeeperMethod(method))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+package scaffolder
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/tendermint/starport/starport/pkg/multiformatname"
+	"github.com/tendermint/starport/starport/pkg/placeholder"
+	"github.com/tendermint/starport/starport/pkg/xgenny"
+	"github.com/tendermint/starport/starport/templates/hook"
+)
+
+// AddHook adds a BeginBlock or EndBlock hook to a module of the scaffolded app
+func (s Scaffolder) AddHook(
+	tracer *placeholder.Tracer,
+	moduleName string,
+	kind hook.Kind,
+) (sm xgenny.SourceModification, err error) {
+	if kind != hook.BeginBlock && kind != hook.EndBlock {
+		return sm, fmt.Errorf("unknown hook %s, must be %s or %s", kind, hook.BeginBlock, hook.EndBlock)
+	}
+
+	// If no module is provided, we add the hook to the app's module
+	if moduleName == "" {
+		moduleName = s.modpath.Package
+	}
+	mfName, err := multiformatname.NewName(moduleName, multiformatname.NoNumber)
+	if err != nil {
+		return sm, err
+	}
+	moduleName = mfName.LowerCase
+
+	ok, err := moduleExists(s.path, moduleName)
+	if err != nil {
+		return sm, err
+	}
+	if !ok {
+		return sm, fmt.Errorf("the module %s doesn't exist", moduleName)
+	}
+
+	// Check the hook has not already been scaffolded
+	hookPath := filepath.Join(s.path, moduleDir, moduleName, "keeper", string(kind)+"_blocker.go")
+	if _, err := os.Stat(hookPath); err == nil {
+		return sm, fmt.Errorf("the module %s already has a hook for the %s block", moduleName, kind)
+	} else if !os.IsNotExist(err) {
+		return sm, err
+	}
+
+	opts := &hook.Options{
+		AppName:    s.modpath.Package,
+		AppPath:    s.path,
+		ModuleName: moduleName,
+		ModulePath: s.modpath.RawPath,
+		Kind:       kind,
+	}
+	g, err := hook.NewStargate(tracer, opts)
+	if err != nil {
+		return sm, err
+	}
+	sm, err = xgenny.RunWithValidation(tracer, g)
+	if err != nil {
+		return sm, err
+	}
+	return sm, finish(opts.AppPath, s.modpath.RawPath)
+}
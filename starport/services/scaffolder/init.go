@@ -28,7 +28,7 @@ var (
 )
 
 // Init initializes a new app with name and given options.
-func Init(tracer *placeholder.Tracer, root, name, addressPrefix string, noDefaultModule bool) (path string, err error) {
+func Init(tracer *placeholder.Tracer, root, name, addressPrefix string, noDefaultModule, authz bool) (path string, err error) {
 	if root, err = filepath.Abs(root); err != nil {
 		return "", err
 	}
@@ -41,7 +41,7 @@ func Init(tracer *placeholder.Tracer, root, name, addressPrefix string, noDefaul
 	path = filepath.Join(root, pathInfo.Root)
 
 	// create the project
-	if err := generate(tracer, pathInfo, addressPrefix, path, noDefaultModule); err != nil {
+	if err := generate(tracer, pathInfo, addressPrefix, path, noDefaultModule, authz); err != nil {
 		return "", err
 	}
 
@@ -63,7 +63,8 @@ func generate(
 	pathInfo gomodulepath.Path,
 	addressPrefix,
 	absRoot string,
-	noDefaultModule bool,
+	noDefaultModule,
+	authz bool,
 ) error {
 	gu, err := giturl.Parse(pathInfo.RawPath)
 	if err != nil {
@@ -79,6 +80,7 @@ func generate(
 		OwnerAndRepoName: gu.UserAndRepo(),
 		BinaryNamePrefix: pathInfo.Root,
 		AddressPrefix:    addressPrefix,
+		Authz:            authz,
 	})
 	if err != nil {
 		return err
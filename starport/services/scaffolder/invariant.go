@@ -0,0 +1,67 @@
+package scaffolder
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/tendermint/starport/starport/pkg/multiformatname"
+	"github.com/tendermint/starport/starport/pkg/placeholder"
+	"github.com/tendermint/starport/starport/pkg/xgenny"
+	"github.com/tendermint/starport/starport/templates/invariant"
+)
+
+// AddInvariant adds a new invariant to a module of the scaffolded app
+func (s Scaffolder) AddInvariant(
+	tracer *placeholder.Tracer,
+	moduleName,
+	invariantName string,
+) (sm xgenny.SourceModification, err error) {
+	// If no module is provided, we add the invariant to the app's module
+	if moduleName == "" {
+		moduleName = s.modpath.Package
+	}
+	mfName, err := multiformatname.NewName(moduleName, multiformatname.NoNumber)
+	if err != nil {
+		return sm, err
+	}
+	moduleName = mfName.LowerCase
+
+	name, err := multiformatname.NewName(invariantName)
+	if err != nil {
+		return sm, err
+	}
+
+	ok, err := moduleExists(s.path, moduleName)
+	if err != nil {
+		return sm, err
+	}
+	if !ok {
+		return sm, fmt.Errorf("the module %s doesn't exist", moduleName)
+	}
+
+	// Check the invariant has not already been scaffolded
+	invariantPath := filepath.Join(s.path, moduleDir, moduleName, "keeper", name.Snake+"_invariant.go")
+	if _, err := os.Stat(invariantPath); err == nil {
+		return sm, fmt.Errorf("the invariant %s already exists in the module %s", name.Original, moduleName)
+	} else if !os.IsNotExist(err) {
+		return sm, err
+	}
+
+	opts := &invariant.Options{
+		AppName:       s.modpath.Package,
+		AppPath:       s.path,
+		ModuleName:    moduleName,
+		ModulePath:    s.modpath.RawPath,
+		InvariantName: name,
+	}
+	g, err := invariant.NewStargate(tracer, opts)
+	if err != nil {
+		return sm, err
+	}
+	sm, err = xgenny.RunWithValidation(tracer, g)
+	if err != nil {
+		return sm, err
+	}
+	return sm, finish(opts.AppPath, s.modpath.RawPath)
+}
@@ -3,21 +3,34 @@ package scaffolder
 import (
 	"context"
 	"fmt"
+	"path/filepath"
 
 	"github.com/gobuffalo/genny"
+	appanalysis "github.com/tendermint/starport/starport/pkg/cosmosanalysis/app"
 	"github.com/tendermint/starport/starport/pkg/multiformatname"
 	"github.com/tendermint/starport/starport/pkg/placeholder"
 	"github.com/tendermint/starport/starport/pkg/xgenny"
 	"github.com/tendermint/starport/starport/templates/field"
 	"github.com/tendermint/starport/starport/templates/field/datatype"
 	"github.com/tendermint/starport/starport/templates/message"
+	"github.com/tendermint/starport/starport/templates/module"
 	modulecreate "github.com/tendermint/starport/starport/templates/module/create"
 )
 
+// authzKeeperName is the name of the authz keeper in the app
+const authzKeeperName = "AuthzKeeper"
+
 // messageOptions represents configuration for the message scaffolding
 type messageOptions struct {
 	description string
 	signer      string
+	withEvents  bool
+
+	// authz makes the message executable with authz
+	authz bool
+
+	// authorization scaffolds a typed authorization for the message
+	authorization bool
 }
 
 // newMessageOptions returns a messageOptions with default options
@@ -45,6 +58,29 @@ func WithSigner(signer string) MessageOption {
 	}
 }
 
+// WithEvents emits a typed event from the message handler
+func WithEvents() MessageOption {
+	return func(m *messageOptions) {
+		m.withEvents = true
+	}
+}
+
+// WithAuthz makes the message executable with authz on behalf of a granter
+func WithAuthz() MessageOption {
+	return func(m *messageOptions) {
+		m.authz = true
+	}
+}
+
+// WithAuthorization scaffolds a typed authorization for the message
+// the message is also made executable with authz
+func WithAuthorization() MessageOption {
+	return func(m *messageOptions) {
+		m.authz = true
+		m.authorization = true
+	}
+}
+
 // AddMessage adds a new message to scaffolded app
 func (s Scaffolder) AddMessage(
 	ctx context.Context,
@@ -80,6 +116,13 @@ func (s Scaffolder) AddMessage(
 		return sm, err
 	}
 
+	// Check the authz module is registered in the app
+	if scaffoldingOpts.authz {
+		if err := appanalysis.CheckKeeper(filepath.Join(s.path, module.PathAppModule), authzKeeperName); err != nil {
+			return sm, fmt.Errorf("the message can't be executed with authz: %s", err.Error())
+		}
+	}
+
 	// Check and parse provided fields
 	if err := checkCustomTypes(ctx, s.path, moduleName, fields); err != nil {
 		return sm, err
@@ -116,6 +159,10 @@ func (s Scaffolder) AddMessage(
 			ResFields:  parsedResFields,
 			MsgDesc:    scaffoldingOpts.description,
 			MsgSigner:  mfSigner,
+			Events:     scaffoldingOpts.withEvents,
+
+			Authz:         scaffoldingOpts.authz,
+			Authorization: scaffoldingOpts.authorization,
 		}
 	)
 
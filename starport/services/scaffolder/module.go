@@ -20,10 +20,10 @@ import (
 	"github.com/tendermint/starport/starport/pkg/placeholder"
 	"github.com/tendermint/starport/starport/pkg/validation"
 	"github.com/tendermint/starport/starport/pkg/xgenny"
-	"github.com/tendermint/starport/starport/templates/field"
 	"github.com/tendermint/starport/starport/templates/module"
 	modulecreate "github.com/tendermint/starport/starport/templates/module/create"
 	moduleimport "github.com/tendermint/starport/starport/templates/module/import"
+	paramsgen "github.com/tendermint/starport/starport/templates/params"
 )
 
 const (
@@ -143,6 +143,7 @@ func WithDependencies(dependencies []modulecreate.Dependency) ModuleCreationOpti
 
 // CreateModule creates a new empty module in the scaffolded app
 func (s Scaffolder) CreateModule(
+	ctx context.Context,
 	tracer *placeholder.Tracer,
 	moduleName string,
 	options ...ModuleCreationOption,
@@ -173,8 +174,8 @@ func (s Scaffolder) CreateModule(
 		apply(&creationOpts)
 	}
 
-	// Parse params with the associated type
-	params, err := field.ParseFields(creationOpts.params, checkForbiddenTypeIndex)
+	// Parse params with the associated type and validation rules
+	params, err := parseParams(creationOpts.params)
 	if err != nil {
 		return sm, err
 	}
@@ -184,10 +185,14 @@ func (s Scaffolder) CreateModule(
 		return sm, err
 	}
 
+	// Resolve the keeper methods imported from the dependencies
+	if err := resolveDependencyMethods(ctx, creationOpts.dependencies, s.path); err != nil {
+		return sm, err
+	}
+
 	opts := &modulecreate.CreateOptions{
 		ModuleName:   moduleName,
 		ModulePath:   s.modpath.RawPath,
-		Params:       params,
 		AppName:      s.modpath.Package,
 		AppPath:      s.path,
 		OwnerName:    owner(s.modpath.RawPath),
@@ -196,6 +201,22 @@ func (s Scaffolder) CreateModule(
 		Dependencies: creationOpts.dependencies,
 	}
 
+	// Generator of the params, created before scaffolding the module to check the params first
+	var paramsGen *genny.Generator
+	if len(params) > 0 {
+		paramsGen, err = paramsgen.NewStargate(tracer, &paramsgen.Options{
+			AppName:    opts.AppName,
+			AppPath:    opts.AppPath,
+			ModuleName: opts.ModuleName,
+			ModulePath: opts.ModulePath,
+			BinaryName: s.modpath.Root + "d",
+			Params:     params,
+		})
+		if err != nil {
+			return sm, err
+		}
+	}
+
 	// Generator from Cosmos SDK version
 	g, err := modulecreate.NewStargate(opts)
 	if err != nil {
@@ -216,6 +237,15 @@ func (s Scaffolder) CreateModule(
 		return sm, err
 	}
 
+	// Scaffold the params of the module
+	if paramsGen != nil {
+		paramsSourceModification, err := xgenny.RunWithValidation(tracer, paramsGen)
+		sm.Merge(paramsSourceModification)
+		if err != nil {
+			return sm, err
+		}
+	}
+
 	// Modify app.go to register the module
 	newSourceModification, runErr := xgenny.RunWithValidation(tracer, modulecreate.NewStargateAppModify(tracer, opts))
 	sm.Merge(newSourceModification)
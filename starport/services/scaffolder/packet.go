@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
+	"time"
 
 	"github.com/gobuffalo/genny"
 	"github.com/tendermint/starport/starport/pkg/multiformatname"
@@ -17,12 +19,16 @@ import (
 
 const (
 	ibcModuleImplementation = "module_ibc.go"
+	ibcOrderingOrdered      = "ORDERED"
+	ibcOrderingUnordered    = "UNORDERED"
+	ibcOrderingNone         = "NONE"
 )
 
 // packetOptions represents configuration for the packet scaffolding
 type packetOptions struct {
 	withoutMessage bool
 	signer         string
+	timeout        time.Duration
 }
 
 // newPacketOptions returns a packetOptions with default options
@@ -49,6 +55,13 @@ func PacketWithSigner(signer string) PacketOption {
 	}
 }
 
+// PacketWithTimeout provides the default relative timeout of the packets sent with the CLI
+func PacketWithTimeout(timeout time.Duration) PacketOption {
+	return func(m *packetOptions) {
+		m.timeout = timeout
+	}
+}
+
 // AddPacket adds a new type stype to scaffolded app by using optional type fields.
 func (s Scaffolder) AddPacket(
 	ctx context.Context,
@@ -93,6 +106,13 @@ func (s Scaffolder) AddPacket(
 	if !ok {
 		return sm, fmt.Errorf("the module %s doesn't implement IBC module interface", moduleName)
 	}
+	if o.timeout < 0 {
+		return sm, fmt.Errorf("the packet timeout %s can't be negative", o.timeout)
+	}
+	ordering, err := ibcModuleOrdering(s.path, moduleName)
+	if err != nil {
+		return sm, err
+	}
 
 	signer := ""
 	if !o.withoutMessage {
@@ -117,6 +137,15 @@ func (s Scaffolder) AddPacket(
 		return sm, err
 	}
 
+	// Coins sent with the packet are escrowed and refunded when the packet fails
+	// this requires the bank keeper as a dependency of the module
+	refund := false
+	if !o.withoutMessage && len(parsedPacketFields.Coins()) > 0 {
+		if refund, err = hasBankDependency(s.path, moduleName); err != nil {
+			return sm, err
+		}
+	}
+
 	// Generate the packet
 	var (
 		g    *genny.Generator
@@ -131,6 +160,9 @@ func (s Scaffolder) AddPacket(
 			AckFields:  parsedAcksFields,
 			NoMessage:  o.withoutMessage,
 			MsgSigner:  mfSigner,
+			Timeout:    o.timeout,
+			Ordering:   ordering,
+			Refund:     refund,
 		}
 	)
 	g, err = ibc.NewPacket(tracer, opts)
@@ -161,6 +193,32 @@ func isIBCModule(appPath string, moduleName string) (bool, error) {
 	return true, err
 }
 
+// ibcModuleOrdering returns the channel ordering enforced by the IBC module
+// the ordering is read from the channel handshake checks of module_ibc.go
+func ibcModuleOrdering(appPath string, moduleName string) (string, error) {
+	content, err := os.ReadFile(filepath.Join(appPath, moduleDir, moduleName, ibcModuleImplementation))
+	if err != nil {
+		return "", err
+	}
+	switch {
+	case strings.Contains(string(content), "channeltypes."+ibcOrderingOrdered+" {"):
+		return ibcOrderingOrdered, nil
+	case strings.Contains(string(content), "channeltypes."+ibcOrderingUnordered+" {"):
+		return ibcOrderingUnordered, nil
+	default:
+		return ibcOrderingNone, nil
+	}
+}
+
+// hasBankDependency returns true if the keeper of the module depends on the bank keeper
+func hasBankDependency(appPath string, moduleName string) (bool, error) {
+	content, err := os.ReadFile(filepath.Join(appPath, moduleDir, moduleName, "keeper/keeper.go"))
+	if err != nil {
+		return false, err
+	}
+	return strings.Contains(string(content), "bankKeeper types.BankKeeper"), nil
+}
+
 // checkForbiddenPacketField returns true if the name is forbidden as a packet name
 func checkForbiddenPacketField(name string) error {
 	mfName, err := multiformatname.NewName(name)
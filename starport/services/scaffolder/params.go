@@ -0,0 +1,89 @@
+package scaffolder
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/tendermint/starport/starport/pkg/multiformatname"
+	"github.com/tendermint/starport/starport/pkg/placeholder"
+	"github.com/tendermint/starport/starport/pkg/xgenny"
+	"github.com/tendermint/starport/starport/templates/field"
+	paramsgen "github.com/tendermint/starport/starport/templates/params"
+)
+
+// AddParams adds new params to an existing module of the scaffolded app
+func (s Scaffolder) AddParams(
+	tracer *placeholder.Tracer,
+	moduleName string,
+	params []string,
+) (sm xgenny.SourceModification, err error) {
+	// If no module is provided, we add the params to the app's module
+	if moduleName == "" {
+		moduleName = s.modpath.Package
+	}
+	mfName, err := multiformatname.NewName(moduleName, multiformatname.NoNumber)
+	if err != nil {
+		return sm, err
+	}
+	moduleName = mfName.LowerCase
+
+	ok, err := moduleExists(s.path, moduleName)
+	if err != nil {
+		return sm, err
+	}
+	if !ok {
+		return sm, fmt.Errorf("the module %s doesn't exist", moduleName)
+	}
+
+	// Parse params with the associated type and validation rules
+	parsedParams, err := parseParams(params)
+	if err != nil {
+		return sm, err
+	}
+
+	// Check the params have not already been scaffolded
+	paramsPath := filepath.Join(s.path, moduleDir, moduleName, "types/params.go")
+	content, err := os.ReadFile(paramsPath)
+	if err != nil {
+		return sm, err
+	}
+	for _, param := range parsedParams {
+		if strings.Contains(string(content), fmt.Sprintf("Key%s = ", param.Name.UpperCamel)) {
+			return sm, fmt.Errorf("the param %s already exists in the module %s", param.Name.Original, moduleName)
+		}
+	}
+
+	opts := &paramsgen.Options{
+		AppName:    s.modpath.Package,
+		AppPath:    s.path,
+		ModuleName: moduleName,
+		ModulePath: s.modpath.RawPath,
+		BinaryName: s.modpath.Root + "d",
+		Params:     parsedParams,
+	}
+	g, err := paramsgen.NewStargate(tracer, opts)
+	if err != nil {
+		return sm, err
+	}
+	sm, err = xgenny.RunWithValidation(tracer, g)
+	if err != nil {
+		return sm, err
+	}
+	return sm, finish(opts.AppPath, s.modpath.RawPath)
+}
+
+// parseParams parses the params with their validation rules, custom types can't be used for params
+func parseParams(params []string) (field.Fields, error) {
+	parsedParams, err := field.ParseFieldsWithRules(params, checkForbiddenTypeIndex)
+	if err != nil {
+		return parsedParams, err
+	}
+	for _, param := range parsedParams {
+		if param.IsCustom() {
+			return parsedParams, fmt.Errorf("the param %s can't have the custom type %s", param.Name.Original, param.Datatype)
+		}
+	}
+	return parsedParams, nil
+}
@@ -2,15 +2,51 @@ package scaffolder
 
 import (
 	"context"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
 
 	"github.com/gobuffalo/genny"
 	"github.com/tendermint/starport/starport/pkg/multiformatname"
 	"github.com/tendermint/starport/starport/pkg/placeholder"
 	"github.com/tendermint/starport/starport/pkg/xgenny"
 	"github.com/tendermint/starport/starport/templates/field"
+	"github.com/tendermint/starport/starport/templates/field/datatype"
 	"github.com/tendermint/starport/starport/templates/query"
 )
 
+// routeParam matches the parameters of a REST route template
+var routeParam = regexp.MustCompile(`\{([^{}]*)\}`)
+
+// queryOptions represents configuration for the query scaffolding
+type queryOptions struct {
+	restRoute  string
+	httpMethod string
+}
+
+// QueryOption configures the query scaffolding
+type QueryOption func(*queryOptions)
+
+// QueryWithRestRoute provides the route template of the REST endpoint of the query
+// the parameters of the route reference request fields, e.g. /posts/{id}
+func QueryWithRestRoute(route string) QueryOption {
+	return func(q *queryOptions) {
+		q.restRoute = route
+	}
+}
+
+// QueryWithHTTPMethod provides the HTTP method of the REST endpoint of the query: get or post
+func QueryWithHTTPMethod(method string) QueryOption {
+	return func(q *queryOptions) {
+		q.httpMethod = method
+	}
+}
+
 // AddQuery adds a new query to scaffolded app
 func (s Scaffolder) AddQuery(
 	ctx context.Context,
@@ -21,7 +57,23 @@ func (s Scaffolder) AddQuery(
 	reqFields,
 	resFields []string,
 	paginated bool,
+	options ...QueryOption,
 ) (sm xgenny.SourceModification, err error) {
+	// Apply the options
+	queryOpts := queryOptions{httpMethod: query.HTTPMethodGet}
+	for _, apply := range options {
+		apply(&queryOpts)
+	}
+	queryOpts.httpMethod = strings.ToLower(queryOpts.httpMethod)
+	if queryOpts.httpMethod != query.HTTPMethodGet && queryOpts.httpMethod != query.HTTPMethodPost {
+		return sm, fmt.Errorf(
+			"unsupported HTTP method %s, must be %s or %s",
+			queryOpts.httpMethod,
+			query.HTTPMethodGet,
+			query.HTTPMethodPost,
+		)
+	}
+
 	// If no module is provided, we add the type to the app's module
 	if moduleName == "" {
 		moduleName = s.modpath.Package
@@ -59,19 +111,39 @@ func (s Scaffolder) AddQuery(
 		return sm, err
 	}
 
+	// Check the route parameters reference request fields
+	owner := owner(s.modpath.RawPath)
+	route, err := restRoute(
+		queryOpts.restRoute,
+		fmt.Sprintf("/%s/%s/%s/", owner, s.modpath.Package, moduleName),
+		parsedReqFields,
+	)
+	if err != nil {
+		return sm, err
+	}
+
+	// Find the response fields returning types stored in the module
+	storedFields, err := storedResponseFields(s.path, moduleName, parsedResFields, paginated)
+	if err != nil {
+		return sm, err
+	}
+
 	var (
 		g    *genny.Generator
 		opts = &query.Options{
-			AppName:     s.modpath.Package,
-			AppPath:     s.path,
-			ModulePath:  s.modpath.RawPath,
-			ModuleName:  moduleName,
-			OwnerName:   owner(s.modpath.RawPath),
-			QueryName:   name,
-			ReqFields:   parsedReqFields,
-			ResFields:   parsedResFields,
-			Description: description,
-			Paginated:   paginated,
+			AppName:      s.modpath.Package,
+			AppPath:      s.path,
+			ModulePath:   s.modpath.RawPath,
+			ModuleName:   moduleName,
+			OwnerName:    owner,
+			QueryName:    name,
+			ReqFields:    parsedReqFields,
+			ResFields:    parsedResFields,
+			Description:  description,
+			Paginated:    paginated,
+			RestRoute:    route,
+			HTTPMethod:   queryOpts.httpMethod,
+			StoredFields: storedFields,
 		}
 	)
 
@@ -86,3 +158,157 @@ func (s Scaffolder) AddQuery(
 	}
 	return sm, finish(opts.AppPath, s.modpath.RawPath)
 }
+
+// restRoute checks the parameters of the route template reference request fields with a scalar type
+// and returns the route with the parameters named after the proto fields
+// a relative route is prefixed with the base path of the module
+func restRoute(route, basePath string, reqFields field.Fields) (string, error) {
+	if route == "" {
+		return "", nil
+	}
+	if !strings.HasPrefix(route, "/") {
+		route = basePath + route
+	}
+
+	var err error
+	route = routeParam.ReplaceAllStringFunc(route, func(param string) string {
+		if err != nil {
+			return param
+		}
+		paramName, nameErr := multiformatname.NewName(strings.Trim(param, "{}"))
+		if nameErr != nil {
+			err = fmt.Errorf("invalid route parameter %s: %s", param, nameErr.Error())
+			return param
+		}
+		for _, reqField := range reqFields {
+			if reqField.Name.LowerCamel != paramName.LowerCamel {
+				continue
+			}
+			switch reqField.DatatypeName {
+			case datatype.String, datatype.Bool, datatype.Int, datatype.Uint:
+				return fmt.Sprintf("{%s}", reqField.Name.LowerCamel)
+			}
+			err = fmt.Errorf("the route parameter %s must be a string, bool, int or uint request field", param)
+			return param
+		}
+		err = fmt.Errorf("the route parameter %s is not a request field", param)
+		return param
+	})
+	return route, err
+}
+
+// storedResponseFields returns the response fields returning types stored in the module
+// a single value is fetched from a singleton, an array or a map from a list or a map
+// the values of the first array or map are fetched with the pagination of the request
+func storedResponseFields(
+	appPath,
+	moduleName string,
+	resFields field.Fields,
+	paginated bool,
+) ([]query.StoredField, error) {
+	var storedFields []query.StoredField
+	for _, resField := range resFields {
+		if !resField.IsCustom() {
+			continue
+		}
+		storage, indexes, err := typeStorage(appPath, moduleName, resField.Datatype)
+		if err != nil {
+			return nil, err
+		}
+
+		stored := query.StoredField{Field: resField, Storage: storage}
+		switch {
+		case resField.DatatypeName == datatype.Custom && storage == query.StorageSingleton:
+		case resField.DatatypeName != datatype.Custom && storage == query.StorageList:
+			stored.MapKey = "strconv.FormatUint(item.Id, 10)"
+		case resField.DatatypeName != datatype.Custom && storage == query.StorageMap:
+			var keys []string
+			for _, index := range indexes {
+				keys = append(keys, index.ToString("item."+index.Name.UpperCamel))
+			}
+			stored.MapKey = strings.Join(keys, ` + "/" + `)
+		default:
+			// the values can't be fetched from the store
+			continue
+		}
+		if resField.DatatypeName != datatype.Custom {
+			stored.Paginated = paginated
+			paginated = false
+		}
+		storedFields = append(storedFields, stored)
+	}
+	return storedFields, nil
+}
+
+// typeStorage returns the storage of a type scaffolded in the module: list, map or singleton
+// the index fields are returned for a map, the storage is empty if the type isn't stored
+func typeStorage(appPath, moduleName, typeName string) (string, field.Fields, error) {
+	typesPath := filepath.Join(appPath, moduleDir, moduleName, "types")
+	name, err := multiformatname.NewName(typeName)
+	if err != nil {
+		return "", nil, err
+	}
+
+	// A map has its own key file with the function building the key from the indexes
+	keyPath := filepath.Join(typesPath, "key_"+name.Snake+".go")
+	if _, err := os.Stat(keyPath); err == nil {
+		indexes, err := mapIndexes(keyPath, name.UpperCamel)
+		return query.StorageMap, indexes, err
+	} else if !os.IsNotExist(err) {
+		return "", nil, err
+	}
+
+	keys, err := os.ReadFile(filepath.Join(typesPath, "keys.go"))
+	if err != nil {
+		return "", nil, err
+	}
+	switch {
+	case strings.Contains(string(keys), name.UpperCamel+"CountKey"):
+		return query.StorageList, nil, nil
+	case regexp.MustCompile(`\b` + name.UpperCamel + `Key\s*=`).Match(keys):
+		return query.StorageSingleton, nil, nil
+	}
+	return "", nil, nil
+}
+
+// mapIndexes returns the index fields of a map from the parameters of its key function
+func mapIndexes(keyPath, typeName string) (indexes field.Fields, err error) {
+	f, err := parser.ParseFile(token.NewFileSet(), keyPath, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+	for _, decl := range f.Decls {
+		funcDecl, ok := decl.(*ast.FuncDecl)
+		if !ok || funcDecl.Name.Name != typeName+"Key" {
+			continue
+		}
+		for _, param := range funcDecl.Type.Params.List {
+			ident, ok := param.Type.(*ast.Ident)
+			if !ok {
+				return nil, fmt.Errorf("unsupported index type in %s", keyPath)
+			}
+			var datatypeName datatype.Name
+			switch ident.Name {
+			case "string":
+				datatypeName = datatype.String
+			case "bool":
+				datatypeName = datatype.Bool
+			case "int32":
+				datatypeName = datatype.Int
+			case "uint64":
+				datatypeName = datatype.Uint
+			default:
+				return nil, fmt.Errorf("unsupported index type %s in %s", ident.Name, keyPath)
+			}
+			for _, paramName := range param.Names {
+				name, err := multiformatname.NewName(paramName.Name)
+				if err != nil {
+					return nil, err
+				}
+				indexes = append(indexes, field.Field{Name: name, DatatypeName: datatypeName})
+			}
+		}
+		return indexes, nil
+	}
+	return nil, fmt.Errorf("the key function of %s not found in %s", typeName, keyPath)
+}
@@ -2,6 +2,7 @@ package scaffolder
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 
@@ -37,6 +38,7 @@ type addTypeOptions struct {
 
 	withoutMessage bool
 	signer         string
+	withEvents     bool
 }
 
 // newAddTypeOptions returns a addTypeOptions with default options
@@ -103,6 +105,13 @@ func TypeWithSigner(signer string) AddTypeOption {
 	}
 }
 
+// TypeWithEvents emits typed events from the message handlers of the type
+func TypeWithEvents() AddTypeOption {
+	return func(o *addTypeOptions) {
+		o.withEvents = true
+	}
+}
+
 // AddType adds a new type to a scaffolded app.
 // if non of the list, map or singleton given, a dry type without anything extra (like a storage layer, models, CLI etc.)
 // will be scaffolded.
@@ -135,6 +144,10 @@ func (s Scaffolder) AddType(
 		return sm, err
 	}
 
+	if o.withEvents && o.withoutMessage {
+		return sm, errors.New("events are emitted by messages and can't be scaffolded without them")
+	}
+
 	signer := ""
 	if !o.withoutMessage {
 		signer = o.signer
@@ -170,6 +183,7 @@ func (s Scaffolder) AddType(
 			TypeName:   name,
 			Fields:     tFields,
 			NoMessage:  o.withoutMessage,
+			Events:     o.withEvents,
 			MsgSigner:  mfSigner,
 			IsIBC:      isIBC,
 		}
@@ -0,0 +1,87 @@
+package scaffolder
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+
+	"github.com/tendermint/starport/starport/pkg/multiformatname"
+	"github.com/tendermint/starport/starport/pkg/placeholder"
+	"github.com/tendermint/starport/starport/pkg/xgenny"
+	"github.com/tendermint/starport/starport/templates/upgrade"
+)
+
+var (
+	// upgradeNameRe matches the names allowed for an upgrade plan
+	upgradeNameRe = regexp.MustCompile(`^[a-zA-Z0-9._-]+$`)
+
+	// consensusVersionRe matches the consensus version declaration of a scaffolded module
+	consensusVersionRe = regexp.MustCompile(`func \(AppModule\) ConsensusVersion\(\) uint64 \{ return (\d+) \}`)
+)
+
+// AddUpgrade scaffolds an upgrade handler in the app and a store migration for the module
+func (s Scaffolder) AddUpgrade(
+	tracer *placeholder.Tracer,
+	moduleName,
+	upgradeName string,
+) (sm xgenny.SourceModification, err error) {
+	if !upgradeNameRe.MatchString(upgradeName) {
+		return sm, fmt.Errorf("%s is not a valid upgrade name", upgradeName)
+	}
+
+	// If no module is provided, we add the migration to the app's module
+	if moduleName == "" {
+		moduleName = s.modpath.Package
+	}
+	mfName, err := multiformatname.NewName(moduleName, multiformatname.NoNumber)
+	if err != nil {
+		return sm, err
+	}
+	moduleName = mfName.LowerCase
+
+	ok, err := moduleExists(s.path, moduleName)
+	if err != nil {
+		return sm, err
+	}
+	if !ok {
+		return sm, fmt.Errorf("the module %s doesn't exist", moduleName)
+	}
+
+	version, err := moduleConsensusVersion(s.path, moduleName)
+	if err != nil {
+		return sm, err
+	}
+
+	opts := &upgrade.Options{
+		AppName:     s.modpath.Package,
+		AppPath:     s.path,
+		ModuleName:  moduleName,
+		ModulePath:  s.modpath.RawPath,
+		UpgradeName: upgradeName,
+		FromVersion: version,
+	}
+	g, err := upgrade.NewStargate(tracer, opts)
+	if err != nil {
+		return sm, err
+	}
+	sm, err = xgenny.RunWithValidation(tracer, g)
+	if err != nil {
+		return sm, err
+	}
+	return sm, finish(opts.AppPath, s.modpath.RawPath)
+}
+
+// moduleConsensusVersion returns the current consensus version of a scaffolded module
+func moduleConsensusVersion(appPath, moduleName string) (uint64, error) {
+	content, err := os.ReadFile(filepath.Join(appPath, moduleDir, moduleName, "module.go"))
+	if err != nil {
+		return 0, err
+	}
+	match := consensusVersionRe.FindSubmatch(content)
+	if match == nil {
+		return 0, fmt.Errorf("the consensus version of the module %s can't be found", moduleName)
+	}
+	return strconv.ParseUint(string(match[1]), 10, 64)
+}
@@ -33,6 +33,7 @@ func New(opts *Options) (*genny.Generator, error) {
 	ctx.Set("OwnerName", opts.OwnerName)
 	ctx.Set("BinaryNamePrefix", opts.BinaryNamePrefix)
 	ctx.Set("AddressPrefix", opts.AddressPrefix)
+	ctx.Set("Authz", opts.Authz)
 
 	// Used for proto package name
 	ctx.Set("formatOwnerName", xstrings.FormatUsername)
@@ -9,6 +9,9 @@ type Options struct {
 	BinaryNamePrefix string
 	ModulePath       string
 	AddressPrefix    string
+
+	// Authz registers the authz module in the app
+	Authz bool
 }
 
 // Validate that options are usuable
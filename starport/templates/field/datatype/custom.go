@@ -28,3 +28,47 @@ var (
 		NonIndex:     true,
 	}
 )
+
+var (
+	// DataCustomSlice custom data type array definition
+	DataCustomSlice = DataType{
+		DataType:         func(datatype string) string { return fmt.Sprintf("[]*%s", datatype) },
+		DefaultTestValue: "[]",
+		ProtoType: func(datatype, name string, index int) string {
+			return fmt.Sprintf("repeated %s %s = %d", datatype, name, index)
+		},
+		GenesisArgs: func(name multiformatname.Name, value int) string {
+			return fmt.Sprintf("%s: nil,\n", name.UpperCamel)
+		},
+		CLIArgs: func(name multiformatname.Name, datatype, prefix string, argIndex int) string {
+			return fmt.Sprintf(`%[1]v%[2]v := make([]*types.%[3]v, 0)
+					err = json.Unmarshal([]byte(args[%[4]v]), &%[1]v%[2]v)
+    				if err != nil {
+                		return err
+            		}`, prefix, name.UpperCamel, datatype, argIndex)
+		},
+		GoCLIImports: []GoImport{{Name: "encoding/json"}},
+		NonIndex:     true,
+	}
+
+	// DataCustomMap custom data type map definition, the values are indexed by string keys
+	DataCustomMap = DataType{
+		DataType:         func(datatype string) string { return fmt.Sprintf("map[string]*%s", datatype) },
+		DefaultTestValue: "{}",
+		ProtoType: func(datatype, name string, index int) string {
+			return fmt.Sprintf("map<string, %s> %s = %d", datatype, name, index)
+		},
+		GenesisArgs: func(name multiformatname.Name, value int) string {
+			return fmt.Sprintf("%s: nil,\n", name.UpperCamel)
+		},
+		CLIArgs: func(name multiformatname.Name, datatype, prefix string, argIndex int) string {
+			return fmt.Sprintf(`%[1]v%[2]v := make(map[string]*types.%[3]v)
+					err = json.Unmarshal([]byte(args[%[4]v]), &%[1]v%[2]v)
+    				if err != nil {
+                		return err
+            		}`, prefix, name.UpperCamel, datatype, argIndex)
+		},
+		GoCLIImports: []GoImport{{Name: "encoding/json"}},
+		NonIndex:     true,
+	}
+)
@@ -30,6 +30,10 @@ const (
 	Coins Name = "array.coin"
 	// Custom represents the custom type name
 	Custom Name = Name(TypeCustom)
+	// CustomSlice represents the custom type array name
+	CustomSlice Name = Name(SlicePrefix + TypeCustom)
+	// CustomMap represents the custom type map name
+	CustomMap Name = Name(MapPrefix + TypeCustom)
 
 	// StringSliceAlias represents the string array type name alias
 	StringSliceAlias Name = "strings"
@@ -42,6 +46,11 @@ const (
 
 	// TypeCustom represents the string type name id
 	TypeCustom = "customstarporttype"
+
+	// SlicePrefix represents the prefix of array type names
+	SlicePrefix = "array."
+	// MapPrefix represents the prefix of map type names
+	MapPrefix = "map."
 )
 
 // SupportedTypes all support data types and definitions
@@ -60,6 +69,8 @@ var SupportedTypes = map[Name]DataType{
 	Coins:            DataCoinSlice,
 	CoinSliceAlias:   DataCoinSlice,
 	Custom:           DataCustom,
+	CustomSlice:      DataCustomSlice,
+	CustomMap:        DataCustomMap,
 }
 
 // Name represents the Alias Name for the data type
@@ -14,6 +14,30 @@ type Field struct {
 	Name         multiformatname.Name
 	DatatypeName datatype.Name
 	Datatype     string
+	Rules        Rules
+}
+
+// IsCustom returns true if the field is a custom type or an array or map of a custom type
+func (f Field) IsCustom() bool {
+	switch f.DatatypeName {
+	case datatype.Custom, datatype.CustomSlice, datatype.CustomMap:
+		return true
+	}
+	return false
+}
+
+// IsCoin returns true if the field is a coin
+func (f Field) IsCoin() bool {
+	return f.DatatypeName == datatype.Coin
+}
+
+// IsCoinSlice returns true if the field is a coin array
+func (f Field) IsCoinSlice() bool {
+	switch f.DatatypeName {
+	case datatype.Coins, datatype.CoinSliceAlias:
+		return true
+	}
+	return false
 }
 
 // DataType returns the field Datatype
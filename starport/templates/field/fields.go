@@ -55,7 +55,7 @@ func (f Fields) String() string {
 func (f Fields) Custom() []string {
 	fields := make([]string, 0)
 	for _, field := range f {
-		if field.DatatypeName == datatype.TypeCustom {
+		if field.IsCustom() {
 			dataType, err := multiformatname.NewName(field.Datatype)
 			if err != nil {
 				panic(err)
@@ -65,3 +65,14 @@ func (f Fields) Custom() []string {
 	}
 	return fields
 }
+
+// Coins return the coin and coin array fields
+func (f Fields) Coins() Fields {
+	fields := make(Fields, 0)
+	for _, field := range f {
+		if field.IsCoin() || field.IsCoinSlice() {
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
@@ -72,11 +72,26 @@ func ParseFields(
 			continue
 		}
 
+		// Custom types can be used in arrays and maps with the array.Type and map.Type formats
+		customType, customDatatypeName := CustomType(datatypeName)
 		parsedFields = append(parsedFields, Field{
 			Name:         name,
-			Datatype:     string(datatypeName),
-			DatatypeName: datatype.TypeCustom,
+			Datatype:     customType,
+			DatatypeName: customDatatypeName,
 		})
 	}
 	return parsedFields, nil
 }
+
+// CustomType returns the name of the custom type referenced by the data type name
+// and the data type name of the custom type, array or map
+func CustomType(datatypeName datatype.Name) (string, datatype.Name) {
+	name := string(datatypeName)
+	switch {
+	case strings.HasPrefix(name, datatype.SlicePrefix):
+		return strings.TrimPrefix(name, datatype.SlicePrefix), datatype.CustomSlice
+	case strings.HasPrefix(name, datatype.MapPrefix):
+		return strings.TrimPrefix(name, datatype.MapPrefix), datatype.CustomMap
+	}
+	return name, datatype.Custom
+}
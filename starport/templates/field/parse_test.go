@@ -164,6 +164,25 @@ func TestParseFields1(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "test custom array and map types",
+			fields: []string{
+				name1.Original + ":array.Bla",
+				name2.Original + ":map.Test",
+			},
+			want: Fields{
+				{
+					Name:         name1,
+					DatatypeName: datatype.CustomSlice,
+					Datatype:     "Bla",
+				},
+				{
+					Name:         name2,
+					DatatypeName: datatype.CustomMap,
+					Datatype:     "Test",
+				},
+			},
+		},
 		{
 			name: "test sdk.Coin types",
 			fields: []string{
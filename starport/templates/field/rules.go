@@ -0,0 +1,191 @@
+package field
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/tendermint/starport/starport/templates/field/datatype"
+)
+
+const (
+	// RuleMin represents the rule checking the minimum value of a number
+	RuleMin = "min"
+	// RuleMax represents the rule checking the maximum value of a number
+	RuleMax = "max"
+	// RuleRegex represents the rule checking a string matches a regular expression
+	RuleRegex = "regex"
+	// RuleNonEmpty represents the rule checking a value is not empty
+	RuleNonEmpty = "nonempty"
+	// RuleDefault represents the rule defining the default value
+	RuleDefault = "default"
+
+	// ruleValueSeparator separates the name of a rule from its value
+	ruleValueSeparator = "="
+)
+
+// Rule represents a validation rule of a field provided with the format rule or rule=value
+type Rule struct {
+	Name  string
+	Value string
+}
+
+// Rules represents the validation rules of a field
+type Rules []Rule
+
+// Get returns the value of the rule with the provided name and true if the rule exists
+func (r Rules) Get(name string) (string, bool) {
+	for _, rule := range r {
+		if rule.Name == name {
+			return rule.Value, true
+		}
+	}
+	return "", false
+}
+
+// ParseFieldsWithRules parses the provided fields with the format name:type:rule1:rule2=value...
+// and checks the rules can be applied to the type of the field
+func ParseFieldsWithRules(
+	fields []string,
+	isForbiddenField func(string) error,
+	forbiddenFieldNames ...string,
+) (Fields, error) {
+	var (
+		specs []string
+		rules []Rules
+	)
+	for _, field := range fields {
+		fieldSplit := strings.Split(field, datatype.Separator)
+		if len(fieldSplit) <= 2 {
+			specs = append(specs, field)
+			rules = append(rules, nil)
+			continue
+		}
+		specs = append(specs, strings.Join(fieldSplit[:2], datatype.Separator))
+
+		var fieldRules Rules
+		for _, rule := range fieldSplit[2:] {
+			ruleSplit := strings.SplitN(rule, ruleValueSeparator, 2)
+			r := Rule{Name: ruleSplit[0]}
+			if len(ruleSplit) == 2 {
+				r.Value = ruleSplit[1]
+			}
+			if _, ok := fieldRules.Get(r.Name); ok {
+				return nil, fmt.Errorf("the rule %s of the field %s is duplicated", r.Name, fieldSplit[0])
+			}
+			fieldRules = append(fieldRules, r)
+		}
+		rules = append(rules, fieldRules)
+	}
+
+	parsedFields, err := ParseFields(specs, isForbiddenField, forbiddenFieldNames...)
+	if err != nil {
+		return parsedFields, err
+	}
+	for i := range parsedFields {
+		if err := checkRules(parsedFields[i].DatatypeName, rules[i]); err != nil {
+			return parsedFields, fmt.Errorf("invalid rules for the field %s: %s", parsedFields[i].Name.Original, err.Error())
+		}
+		parsedFields[i].Rules = rules[i]
+	}
+	return parsedFields, nil
+}
+
+// checkRules checks the rules are valid and can be applied to the data type
+func checkRules(datatypeName datatype.Name, rules Rules) error {
+	for _, rule := range rules {
+		switch rule.Name {
+		case RuleMin, RuleMax:
+			if err := checkNumber(datatypeName, rule); err != nil {
+				return err
+			}
+		case RuleDefault:
+			switch datatypeName {
+			case datatype.String:
+			case datatype.Bool:
+				if _, err := strconv.ParseBool(rule.Value); err != nil {
+					return fmt.Errorf("%s is not a valid bool", rule.Value)
+				}
+			default:
+				if err := checkNumber(datatypeName, rule); err != nil {
+					return err
+				}
+			}
+		case RuleRegex:
+			if datatypeName != datatype.String {
+				return fmt.Errorf("the rule %s can only be applied to a string", rule.Name)
+			}
+			if _, err := regexp.Compile(rule.Value); err != nil {
+				return err
+			}
+		case RuleNonEmpty:
+			if rule.Value != "" {
+				return fmt.Errorf("the rule %s doesn't have a value", rule.Name)
+			}
+			switch datatypeName {
+			case datatype.Int, datatype.Uint, datatype.Bool, datatype.Custom:
+				return fmt.Errorf("the rule %s can't be applied to the type %s", rule.Name, datatypeName)
+			}
+		default:
+			return fmt.Errorf("unknown rule %s", rule.Name)
+		}
+	}
+
+	minValue, hasMin := rules.Get(RuleMin)
+	maxValue, hasMax := rules.Get(RuleMax)
+	if hasMin && hasMax {
+		minNumber, _ := strconv.ParseFloat(minValue, 64)
+		maxNumber, _ := strconv.ParseFloat(maxValue, 64)
+		if minNumber > maxNumber {
+			return fmt.Errorf("the min value %s is greater than the max value %s", minValue, maxValue)
+		}
+	}
+
+	// the default value must satisfy the other rules
+	if defaultValue, ok := rules.Get(RuleDefault); ok {
+		if err := checkDefault(defaultValue, rules); err != nil {
+			return fmt.Errorf("the default value %s is invalid: %s", defaultValue, err.Error())
+		}
+	}
+	return nil
+}
+
+// checkDefault checks the default value satisfies the rules
+func checkDefault(defaultValue string, rules Rules) error {
+	value, _ := strconv.ParseFloat(defaultValue, 64)
+	if minValue, ok := rules.Get(RuleMin); ok {
+		if minNumber, _ := strconv.ParseFloat(minValue, 64); value < minNumber {
+			return fmt.Errorf("lower than %s", minValue)
+		}
+	}
+	if maxValue, ok := rules.Get(RuleMax); ok {
+		if maxNumber, _ := strconv.ParseFloat(maxValue, 64); value > maxNumber {
+			return fmt.Errorf("greater than %s", maxValue)
+		}
+	}
+	if expr, ok := rules.Get(RuleRegex); ok && !regexp.MustCompile(expr).MatchString(defaultValue) {
+		return fmt.Errorf("doesn't match %s", expr)
+	}
+	if _, ok := rules.Get(RuleNonEmpty); ok && defaultValue == "" {
+		return fmt.Errorf("empty")
+	}
+	return nil
+}
+
+// checkNumber checks the value of the rule is a number matching the data type
+func checkNumber(datatypeName datatype.Name, rule Rule) error {
+	var err error
+	switch datatypeName {
+	case datatype.Int:
+		_, err = strconv.ParseInt(rule.Value, 10, 32)
+	case datatype.Uint:
+		_, err = strconv.ParseUint(rule.Value, 10, 64)
+	default:
+		return fmt.Errorf("the rule %s can only be applied to a number", rule.Name)
+	}
+	if err != nil {
+		return fmt.Errorf("%s is not a valid %s", rule.Value, datatypeName)
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+package field
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFieldsWithRules(t *testing.T) {
+	fields, err := ParseFieldsWithRules([]string{
+		"foo",
+		"bar:uint:min=1:max=100",
+		"baz:string:regex=^[a-z]+$:default=abc",
+		"qux:coins:nonempty",
+	}, noCheck)
+	require.NoError(t, err)
+	require.Len(t, fields, 4)
+	require.Empty(t, fields[0].Rules)
+	require.Equal(t, Rules{{Name: RuleMin, Value: "1"}, {Name: RuleMax, Value: "100"}}, fields[1].Rules)
+	require.Equal(t, Rules{{Name: RuleRegex, Value: "^[a-z]+$"}, {Name: RuleDefault, Value: "abc"}}, fields[2].Rules)
+	require.Equal(t, Rules{{Name: RuleNonEmpty}}, fields[3].Rules)
+
+	value, ok := fields[2].Rules.Get(RuleDefault)
+	require.True(t, ok)
+	require.Equal(t, "abc", value)
+	_, ok = fields[2].Rules.Get(RuleMin)
+	require.False(t, ok)
+}
+
+func TestParseFieldsWithInvalidRules(t *testing.T) {
+	tests := []struct {
+		name  string
+		field string
+	}{
+		{name: "unknown rule", field: "foo:int:foo"},
+		{name: "duplicated rule", field: "foo:int:min=1:min=2"},
+		{name: "min on a string", field: "foo:string:min=1"},
+		{name: "invalid number", field: "foo:uint:max=-1"},
+		{name: "min greater than max", field: "foo:int:min=10:max=1"},
+		{name: "regex on a number", field: "foo:int:regex=^[0-9]+$"},
+		{name: "invalid regex", field: "foo:string:regex=["},
+		{name: "nonempty with a value", field: "foo:string:nonempty=true"},
+		{name: "nonempty on a bool", field: "foo:bool:nonempty"},
+		{name: "invalid default bool", field: "foo:bool:default=foo"},
+		{name: "default out of range", field: "foo:int:max=10:default=11"},
+		{name: "default not matching", field: "foo:string:regex=^[0-9]+$:default=abc"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseFieldsWithRules([]string{tt.field}, noCheck)
+			require.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,102 @@
+package hook
+
+import (
+	"embed"
+	"fmt"
+	"path/filepath"
+
+	"github.com/gobuffalo/genny"
+	"github.com/gobuffalo/plush"
+	"github.com/gobuffalo/plushgen"
+	"github.com/tendermint/starport/starport/pkg/placeholder"
+	"github.com/tendermint/starport/starport/pkg/xgenny"
+	"github.com/tendermint/starport/starport/templates/field/plushhelpers"
+	"github.com/tendermint/starport/starport/templates/module"
+)
+
+var (
+	//go:embed begin/* begin/**/*
+	fsBegin embed.FS
+
+	//go:embed end/* end/**/*
+	fsEnd embed.FS
+)
+
+// NewStargate returns the generator to scaffold a BeginBlock or EndBlock hook in a Stargate module
+func NewStargate(replacer placeholder.Replacer, opts *Options) (*genny.Generator, error) {
+	g := genny.New()
+
+	var template xgenny.Walker
+	switch opts.Kind {
+	case BeginBlock:
+		template = xgenny.NewEmbedWalker(fsBegin, "begin/", opts.AppPath)
+	case EndBlock:
+		template = xgenny.NewEmbedWalker(fsEnd, "end/", opts.AppPath)
+	default:
+		return g, fmt.Errorf("unknown hook kind %s", opts.Kind)
+	}
+
+	g.RunFn(moduleModify(replacer, opts))
+	g.RunFn(appModify(replacer, opts))
+
+	if err := g.Box(template); err != nil {
+		return g, err
+	}
+	ctx := plush.NewContext()
+	ctx.Set("moduleName", opts.ModuleName)
+	ctx.Set("modulePath", opts.ModulePath)
+	ctx.Set("appName", opts.AppName)
+
+	plushhelpers.ExtendPlushContext(ctx)
+	g.Transformer(plushgen.Transformer(ctx))
+	g.Transformer(genny.Replace("{{moduleName}}", opts.ModuleName))
+	return g, nil
+}
+
+// moduleModify calls the hook from the ABCI method of the module
+func moduleModify(replacer placeholder.Replacer, opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "module.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		modulePlaceholder, method := PlaceholderModuleBeginBlock, "BeginBlocker"
+		if opts.Kind == EndBlock {
+			modulePlaceholder, method = PlaceholderModuleEndBlock, "EndBlocker"
+		}
+
+		template := `am.keeper.%[2]v(ctx)
+	%[1]v`
+		replacement := fmt.Sprintf(template, modulePlaceholder, method)
+		content := replacer.Replace(f.String(), modulePlaceholder, replacement)
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+// appModify adds the module to the order of the modules executing the ABCI method
+func appModify(replacer placeholder.Replacer, opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, module.PathAppGo)
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		appPlaceholder := module.PlaceholderSgAppBeginBlockers
+		if opts.Kind == EndBlock {
+			appPlaceholder = module.PlaceholderSgAppEndBlockers
+		}
+
+		template := `%[2]vmoduletypes.ModuleName,
+%[1]v`
+		replacement := fmt.Sprintf(template, appPlaceholder, opts.ModuleName)
+		content := replacer.Replace(f.String(), appPlaceholder, replacement)
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
@@ -0,0 +1,21 @@
+package hook
+
+// Kind represents the ABCI method a hook is executed in
+type Kind string
+
+const (
+	// BeginBlock hooks are executed at the beginning of every block
+	BeginBlock Kind = "begin"
+
+	// EndBlock hooks are executed at the end of every block
+	EndBlock Kind = "end"
+)
+
+// Options represents the options to scaffold a module hook
+type Options struct {
+	AppName    string
+	AppPath    string
+	ModuleName string
+	ModulePath string
+	Kind       Kind
+}
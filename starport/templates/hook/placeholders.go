@@ -0,0 +1,6 @@
+package hook
+
+const (
+	PlaceholderModuleBeginBlock = "// this line is used by starport scaffolding # module/beginBlock"
+	PlaceholderModuleEndBlock   = "// this line is used by starport scaffolding # module/endBlock"
+)
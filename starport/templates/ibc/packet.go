@@ -3,8 +3,10 @@ package ibc
 import (
 	"embed"
 	"fmt"
+	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/gobuffalo/genny"
 	"github.com/gobuffalo/plush"
@@ -24,6 +26,9 @@ var (
 
 	//go:embed packet/messages/* packet/messages/**/*
 	fsPacketMessages embed.FS
+
+	//go:embed packet/refund/* packet/refund/**/*
+	fsPacketRefund embed.FS
 )
 
 // PacketOptions are options to scaffold a packet in a IBC module
@@ -38,6 +43,21 @@ type PacketOptions struct {
 	Fields     field.Fields
 	AckFields  field.Fields
 	NoMessage  bool
+
+	// Timeout is the default relative timeout of the packets sent with the CLI
+	// the default timeout of the module is used if zero
+	Timeout time.Duration
+
+	// Ordering is the channel ordering enforced by the module: ORDERED, UNORDERED or NONE
+	Ordering string
+
+	// Refund enables escrowing the coins of the packet and refunding them on timeout or error acknowledgment
+	Refund bool
+}
+
+// IsOrdered returns true if the packet is sent over an ordered channel
+func (opts *PacketOptions) IsOrdered() bool {
+	return opts.Ordering == "ORDERED"
 }
 
 // NewPacket returns the generator to scaffold a packet in an IBC module
@@ -76,6 +96,27 @@ func NewPacket(replacer placeholder.Replacer, opts *PacketOptions) (*genny.Gener
 		}
 	}
 
+	// Add the escrow of the coins sent with the packet
+	if opts.Refund {
+		g.RunFn(expectedKeepersModify(opts))
+		g.RunFn(keepersMocksModify(opts))
+
+		// The escrow address helper is shared by the packets of the module
+		escrowPath := filepath.Join(opts.AppPath, "x", opts.ModuleName, "types/escrow.go")
+		if _, err := os.Stat(escrowPath); os.IsNotExist(err) {
+			refundTemplate := xgenny.NewEmbedWalker(
+				fsPacketRefund,
+				"packet/refund/",
+				opts.AppPath,
+			)
+			if err := g.Box(refundTemplate); err != nil {
+				return g, err
+			}
+		} else if err != nil {
+			return g, err
+		}
+	}
+
 	ctx := plush.NewContext()
 	ctx.Set("moduleName", opts.ModuleName)
 	ctx.Set("ModulePath", opts.ModulePath)
@@ -85,6 +126,23 @@ func NewPacket(replacer placeholder.Replacer, opts *PacketOptions) (*genny.Gener
 	ctx.Set("ownerName", opts.OwnerName)
 	ctx.Set("fields", opts.Fields)
 	ctx.Set("ackFields", opts.AckFields)
+	ctx.Set("coinFields", opts.Fields.Coins())
+	ordering := opts.Ordering
+	if ordering == "" {
+		ordering = "NONE"
+	}
+	ctx.Set("ordering", ordering)
+	ctx.Set("isOrdered", opts.IsOrdered())
+	ctx.Set("refund", opts.Refund)
+
+	// The default relative timeout timestamp of the CLI
+	if opts.Timeout > 0 {
+		ctx.Set("timeoutDefault", fmt.Sprintf("uint64(%d)", opts.Timeout.Nanoseconds()))
+		ctx.Set("timeoutDescription", opts.Timeout.String())
+	} else {
+		ctx.Set("timeoutDefault", "DefaultRelativePacketTimeoutTimestamp")
+		ctx.Set("timeoutDescription", "10 minutes")
+	}
 
 	plushhelpers.ExtendPlushContext(ctx)
 	g.Transformer(plushgen.Transformer(ctx))
@@ -202,6 +260,11 @@ func protoModify(replacer placeholder.Replacer, opts *PacketOptions) genny.RunFn
 			packetFields += fmt.Sprintf("  %s;\n", field.ProtoType(i+1))
 		}
 
+		// The sender of the escrowed coins is refunded when the packet fails
+		if opts.Refund {
+			packetFields += fmt.Sprintf("  string sender = %d;\n", len(opts.Fields)+1)
+		}
+
 		var ackFields string
 		for i, field := range opts.AckFields {
 			ackFields += fmt.Sprintf("  %s;\n", field.ProtoType(i+1))
@@ -289,7 +352,7 @@ func protoTxModify(replacer placeholder.Replacer, opts *PacketOptions) genny.Run
 
 		var sendFields string
 		for i, field := range opts.Fields {
-			sendFields += fmt.Sprintf("  %s;\n", field.ProtoType(i+5))
+			sendFields += fmt.Sprintf("  %s;\n", field.ProtoType(i+7))
 		}
 
 		// Ensure custom types are imported
@@ -309,14 +372,15 @@ import "%[1]v";`, f)
 		}
 
 		// Message
-		// TODO: Include timestamp height
-		// This addition would include using the type ibc.core.client.v1.Height
-		// Ex: https://github.com/cosmos/cosmos-sdk/blob/816306b85addae6350bd380997f2f4bf9dce9471/proto/ibc/applications/transfer/v1/tx.proto
+		// The timeout height is defined with its revision number and height
+		// since the proto files of ibc-go are not included in the app
 		templateMessage := `message MsgSend%[2]v {
   string %[3]v = 1;
   string port = 2;
   string channelID = 3;
   uint64 timeoutTimestamp = 4;
+  uint64 timeoutRevisionNumber = 5;
+  uint64 timeoutRevisionHeight = 6;
 %[4]v}
 
 message MsgSend%[2]vResponse {
@@ -373,6 +437,16 @@ func clientCliTxModify(replacer placeholder.Replacer, opts *PacketOptions) genny
 %[1]v`
 		replacement := fmt.Sprintf(template, Placeholder, opts.PacketName.UpperCamel)
 		content := replacer.Replace(f.String(), Placeholder, replacement)
+
+		// Ensure the timeout flags are defined for the modules scaffolded without them
+		if !strings.Contains(content, "flagPacketTimeoutHeight") {
+			content = strings.Replace(content, "const (", `const (
+	flagPacketTimeoutHeight = "packet-timeout-height"
+	flagAbsoluteTimeouts = "absolute-timeouts"`, 1)
+			content = strings.Replace(content, "var (", `var (
+	DefaultRelativePacketTimeoutHeight = "0-1000"`, 1)
+		}
+
 		newFile := genny.NewFileS(path, content)
 		return r.File(newFile)
 	}
@@ -413,3 +487,48 @@ func codecModify(replacer placeholder.Replacer, opts *PacketOptions) genny.RunFn
 		return r.File(newFile)
 	}
 }
+
+func expectedKeepersModify(opts *PacketOptions) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "types/expected_keepers.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+		content := f.String()
+
+		// Ensure the bank keeper can send the escrowed coins
+		if !strings.Contains(content, "SendCoins(") {
+			content = strings.Replace(content, "type BankKeeper interface {", `type BankKeeper interface {
+	SendCoins(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error`, 1)
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+func keepersMocksModify(opts *PacketOptions) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "testutil/expected_keepers_mocks.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			// The mocks are only scaffolded for the modules with dependencies
+			return nil
+		}
+		content := f.String()
+
+		if !strings.Contains(content, ") SendCoins(") {
+			content += `
+// SendCoins mocks the SendCoins method of the bank keeper
+func (m *BankKeeper) SendCoins(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	args := m.Called(ctx, fromAddr, toAddr, amt)
+	return args.Error(0)
+}
+`
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
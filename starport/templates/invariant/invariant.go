@@ -0,0 +1,72 @@
+package invariant
+
+import (
+	"embed"
+	"fmt"
+	"path/filepath"
+
+	"github.com/gobuffalo/genny"
+	"github.com/gobuffalo/plush"
+	"github.com/gobuffalo/plushgen"
+	"github.com/tendermint/starport/starport/pkg/placeholder"
+	"github.com/tendermint/starport/starport/pkg/xgenny"
+	"github.com/tendermint/starport/starport/templates/field/plushhelpers"
+)
+
+var (
+	//go:embed stargate/* stargate/**/*
+	fsStargate embed.FS
+)
+
+// NewStargate returns the generator to scaffold an invariant in a Stargate module
+func NewStargate(replacer placeholder.Replacer, opts *Options) (*genny.Generator, error) {
+	var (
+		g        = genny.New()
+		template = xgenny.NewEmbedWalker(
+			fsStargate,
+			"stargate/",
+			opts.AppPath,
+		)
+	)
+
+	g.RunFn(moduleModify(replacer, opts))
+
+	if err := g.Box(template); err != nil {
+		return g, err
+	}
+	ctx := plush.NewContext()
+	ctx.Set("moduleName", opts.ModuleName)
+	ctx.Set("modulePath", opts.ModulePath)
+	ctx.Set("appName", opts.AppName)
+	ctx.Set("invariantName", opts.InvariantName)
+
+	plushhelpers.ExtendPlushContext(ctx)
+	g.Transformer(plushgen.Transformer(ctx))
+	g.Transformer(genny.Replace("{{moduleName}}", opts.ModuleName))
+	g.Transformer(genny.Replace("{{invariantName}}", opts.InvariantName.Snake))
+	return g, nil
+}
+
+// moduleModify registers the invariant in the module
+func moduleModify(replacer placeholder.Replacer, opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "module.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		template := `ir.RegisterRoute(types.ModuleName, "%[2]v", keeper.%[3]vInvariant(am.keeper))
+	%[1]v`
+		replacement := fmt.Sprintf(
+			template,
+			PlaceholderModuleInvariant,
+			opts.InvariantName.Kebab,
+			opts.InvariantName.UpperCamel,
+		)
+		content := replacer.Replace(f.String(), PlaceholderModuleInvariant, replacement)
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
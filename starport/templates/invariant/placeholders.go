@@ -0,0 +1,3 @@
+package invariant
+
+const PlaceholderModuleInvariant = "// this line is used by starport scaffolding # module/invariant"
@@ -14,6 +14,15 @@ import (
 var (
 	//go:embed stargate/* stargate/**/*
 	fsStargate embed.FS
+
+	//go:embed authz/* authz/**/*
+	fsAuthz embed.FS
+
+	//go:embed authorization/* authorization/**/*
+	fsAuthorization embed.FS
+
+	//go:embed events/* events/**/*
+	fsEvents embed.FS
 )
 
 func Box(box packd.Walker, opts *Options, g *genny.Generator) error {
@@ -30,6 +39,9 @@ func Box(box packd.Walker, opts *Options, g *genny.Generator) error {
 	ctx.Set("ModulePath", opts.ModulePath)
 	ctx.Set("Fields", opts.Fields)
 	ctx.Set("ResFields", opts.ResFields)
+	ctx.Set("Events", opts.Events)
+	ctx.Set("Authz", opts.Authz)
+	ctx.Set("Authorization", opts.Authorization)
 
 	plushhelpers.ExtendPlushContext(ctx)
 	g.Transformer(plushgen.Transformer(ctx))
@@ -17,6 +17,13 @@ type Options struct {
 	MsgDesc    string
 	Fields     field.Fields
 	ResFields  field.Fields
+	Events     bool
+
+	// Authz makes the message executable with authz on behalf of a granter
+	Authz bool
+
+	// Authorization scaffolds a typed authorization for the message
+	Authorization bool
 }
 
 // Validate that options are usuable
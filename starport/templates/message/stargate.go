@@ -20,6 +20,38 @@ func NewStargate(replacer placeholder.Replacer, opts *Options) (*genny.Generator
 	g.RunFn(protoTxMessageModify(replacer, opts))
 	g.RunFn(typesCodecModify(replacer, opts))
 	g.RunFn(clientCliTxModify(replacer, opts))
+	g.RunFn(typed.ModuleSimulationMsgModify(replacer, opts.AppPath, opts.ModuleName, opts.MsgName))
+
+	if opts.Authz {
+		authzTemplate := xgenny.NewEmbedWalker(
+			fsAuthz,
+			"authz/",
+			opts.AppPath,
+		)
+		if err := g.Box(authzTemplate); err != nil {
+			return g, err
+		}
+	}
+	if opts.Events {
+		eventsTemplate := xgenny.NewEmbedWalker(
+			fsEvents,
+			"events/",
+			opts.AppPath,
+		)
+		if err := g.Box(eventsTemplate); err != nil {
+			return g, err
+		}
+	}
+	if opts.Authorization {
+		authorizationTemplate := xgenny.NewEmbedWalker(
+			fsAuthorization,
+			"authorization/",
+			opts.AppPath,
+		)
+		if err := g.Box(authorizationTemplate); err != nil {
+			return g, err
+		}
+	}
 
 	template := xgenny.NewEmbedWalker(
 		fsStargate,
@@ -90,6 +122,25 @@ func protoTxMessageModify(replacer placeholder.Replacer, opts *Options) genny.Ru
 			resFields += fmt.Sprintf("  %s;\n", field.ProtoType(i+1))
 		}
 
+		// The typed authorization of the message can be customized with fields
+		var authorization string
+		if opts.Authorization {
+			authorization = fmt.Sprintf(`message %[1]vAuthorization {
+}
+
+`, opts.MsgName.UpperCamel)
+		}
+
+		// The event holds the same fields as the message
+		var event string
+		if opts.Events {
+			event = fmt.Sprintf(`message Event%[1]v {
+  string %[2]v = 1;
+%[3]v}
+
+`, opts.MsgName.UpperCamel, opts.MsgSigner.LowerCamel, msgFields)
+		}
+
 		template := `message Msg%[2]v {
   string %[5]v = 1;
 %[3]v}
@@ -97,13 +148,15 @@ func protoTxMessageModify(replacer placeholder.Replacer, opts *Options) genny.Ru
 message Msg%[2]vResponse {
 %[4]v}
 
-%[1]v`
+%[6]v%[7]v%[1]v`
 		replacement := fmt.Sprintf(template,
 			PlaceholderProtoTxMessage,
 			opts.MsgName.UpperCamel,
 			msgFields,
 			resFields,
 			opts.MsgSigner.LowerCamel,
+			event,
+			authorization,
 		)
 		content := replacer.Replace(f.String(), PlaceholderProtoTxMessage, replacement)
 
@@ -160,6 +213,25 @@ func typesCodecModify(replacer placeholder.Replacer, opts *Options) genny.RunFn
 		)
 		content = replacer.Replace(content, Placeholder3, replacementRegisterImplementations)
 
+		// Register the typed authorization of the message
+		if opts.Authorization {
+			authzImport := `"github.com/cosmos/cosmos-sdk/x/authz"`
+			if !strings.Contains(content, authzImport) {
+				content = strings.Replace(content, "import (", "import (\n\t"+authzImport, 1)
+			}
+
+			templateRegisterAuthorization := `registry.RegisterImplementations((*authz.Authorization)(nil),
+	&%[2]vAuthorization{},
+)
+%[1]v`
+			replacementRegisterAuthorization := fmt.Sprintf(
+				templateRegisterAuthorization,
+				Placeholder3,
+				opts.MsgName.UpperCamel,
+			)
+			content = replacer.Replace(content, Placeholder3, replacementRegisterAuthorization)
+		}
+
 		newFile := genny.NewFileS(path, content)
 		return r.File(newFile)
 	}
@@ -174,6 +246,11 @@ func clientCliTxModify(replacer placeholder.Replacer, opts *Options) genny.RunFn
 		}
 		template := `cmd.AddCommand(Cmd%[2]v())
 %[1]v`
+		if opts.Authz {
+			template = `cmd.AddCommand(Cmd%[2]v())
+	cmd.AddCommand(CmdGrant%[2]v())
+%[1]v`
+		}
 		replacement := fmt.Sprintf(template, Placeholder, opts.MsgName.UpperCamel)
 		content := replacer.Replace(f.String(), Placeholder, replacement)
 		newFile := genny.NewFileS(path, content)
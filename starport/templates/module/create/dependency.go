@@ -0,0 +1,150 @@
+package modulecreate
+
+import (
+	"fmt"
+	"go/types"
+	"path"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+const sdkTypesPath = "github.com/cosmos/cosmos-sdk/types"
+
+// defaultKeeperMethods are the methods always defined in the expected keepers of a module
+var defaultKeeperMethods = map[string]string{
+	"account": "GetAccount",
+	"bank":    "SpendableCoins",
+}
+
+// reservedParamNames are the names used by the generated mocks that can't be used as parameter names
+var reservedParamNames = map[string]bool{
+	"m":    true,
+	"args": true,
+}
+
+// KeeperMethod represents a keeper method imported by a module from one of its dependencies
+type KeeperMethod struct {
+	Name string
+
+	// Signature contains the parameters and the results of the method
+	Signature string
+
+	// Args contains the names of the parameters of the method separated by commas
+	Args string
+
+	// Results contains the types of the results of the method
+	Results []string
+
+	// ResultVars contains the names of the variables returned by the method mock separated by commas
+	ResultVars string
+
+	// Imports contains the imports required by the signature of the method
+	Imports []string
+}
+
+// NewKeeperMethod returns the keeper method to import from the method of a keeper type
+func NewKeeperMethod(method *types.Func) KeeperMethod {
+	sig := method.Type().(*types.Signature)
+	imports := make(map[string]struct{})
+	qualifier := func(pkg *types.Package) string {
+		alias := importAlias(pkg)
+		imports[fmt.Sprintf("%s %q", alias, pkg.Path())] = struct{}{}
+		return alias
+	}
+
+	var params, args []string
+	for i := 0; i < sig.Params().Len(); i++ {
+		param := sig.Params().At(i)
+		name := param.Name()
+		if name == "" || name == "_" || reservedParamNames[name] {
+			name = fmt.Sprintf("p%d", i)
+		}
+		typ := types.TypeString(param.Type(), qualifier)
+		if sig.Variadic() && i == sig.Params().Len()-1 {
+			typ = "..." + types.TypeString(param.Type().(*types.Slice).Elem(), qualifier)
+		}
+		params = append(params, fmt.Sprintf("%s %s", name, typ))
+		args = append(args, name)
+	}
+
+	var results, resultVars []string
+	for i := 0; i < sig.Results().Len(); i++ {
+		results = append(results, types.TypeString(sig.Results().At(i).Type(), qualifier))
+		resultVars = append(resultVars, fmt.Sprintf("r%d", i))
+	}
+
+	signature := fmt.Sprintf("(%s)", strings.Join(params, ", "))
+	switch len(results) {
+	case 0:
+	case 1:
+		signature += " " + results[0]
+	default:
+		signature += fmt.Sprintf(" (%s)", strings.Join(results, ", "))
+	}
+
+	m := KeeperMethod{
+		Name:       method.Name(),
+		Signature:  signature,
+		Args:       strings.Join(args, ", "),
+		Results:    results,
+		ResultVars: strings.Join(resultVars, ", "),
+	}
+	for imp := range imports {
+		m.Imports = append(m.Imports, imp)
+	}
+	sort.Strings(m.Imports)
+	return m
+}
+
+// AddKeeperMethod adds a method to the expected keeper of the dependency
+// the methods already defined by default in the expected keeper are ignored
+func (d *Dependency) AddKeeperMethod(method KeeperMethod) {
+	if defaultKeeperMethods[d.Name] == method.Name {
+		return
+	}
+	d.KeeperMethods = append(d.KeeperMethods, method)
+}
+
+// mergeKeeperImports returns the imports required by the keeper methods of the dependencies
+// the Cosmos SDK types and auth types are omitted since they are always imported
+func mergeKeeperImports(dependencies []Dependency) []string {
+	imports := make(map[string]struct{})
+	for _, dep := range dependencies {
+		for _, method := range dep.KeeperMethods {
+			for _, imp := range method.Imports {
+				imports[imp] = struct{}{}
+			}
+		}
+	}
+	delete(imports, fmt.Sprintf("sdk %q", sdkTypesPath))
+	delete(imports, `authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"`)
+
+	var merged []string
+	for imp := range imports {
+		merged = append(merged, imp)
+	}
+	sort.Strings(merged)
+	return merged
+}
+
+// importAlias returns the alias used to import a package in the scaffolded code
+// generic package names like types or keeper are prefixed with the name of their parent directory
+func importAlias(pkg *types.Package) string {
+	if pkg.Path() == sdkTypesPath {
+		return "sdk"
+	}
+	alias := pkg.Name()
+	if alias == "types" || alias == "keeper" {
+		alias = path.Base(path.Dir(pkg.Path())) + alias
+	}
+
+	// remove the characters that can't be used in an identifier like in "02-client"
+	alias = strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return r
+		}
+		return -1
+	}, alias)
+	return strings.TrimLeftFunc(alias, unicode.IsDigit)
+}
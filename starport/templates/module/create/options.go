@@ -3,8 +3,6 @@ package modulecreate
 import (
 	"fmt"
 	"strings"
-
-	"github.com/tendermint/starport/starport/templates/field"
 )
 
 // CreateOptions represents the options to scaffold a Cosmos SDK module
@@ -14,7 +12,6 @@ type CreateOptions struct {
 	AppName    string
 	AppPath    string
 	OwnerName  string
-	Params     field.Fields
 
 	// True if the module should implement the IBC module interface
 	IsIBC bool
@@ -43,17 +40,22 @@ func (opts *CreateOptions) Validate() error {
 // Dependency represents a module dependency of a module
 type Dependency struct {
 	Name       string
-	KeeperName string // KeeperName represents the name of the keeper for the module in app.go
+	KeeperName string   // KeeperName represents the name of the keeper for the module in app.go
+	Methods    []string // Methods are the names of the keeper methods used by the module
+
+	// KeeperMethods are the keeper methods added to the expected keeper interface
+	KeeperMethods []KeeperMethod
 }
 
 // NewDependency returns a new dependency object
-func NewDependency(name, keeperName string) Dependency {
+func NewDependency(name, keeperName string, methods ...string) Dependency {
 	// Default keeper name
 	if keeperName == "" {
 		keeperName = fmt.Sprintf("%sKeeper", strings.Title(name))
 	}
 	return Dependency{
-		name,
-		keeperName,
+		Name:       name,
+		KeeperName: keeperName,
+		Methods:    methods,
 	}
 }
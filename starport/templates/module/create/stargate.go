@@ -45,18 +45,30 @@ func NewStargate(opts *CreateOptions) (*genny.Generator, error) {
 	if err := g.Box(stargateTemplate); err != nil {
 		return g, err
 	}
+	if len(opts.Dependencies) > 0 {
+		dependenciesTemplate := xgenny.NewEmbedWalker(
+			fsDependencies,
+			"dependencies/",
+			opts.AppPath,
+		)
+		if err := g.Box(dependenciesTemplate); err != nil {
+			return g, err
+		}
+	}
 	ctx := plush.NewContext()
 	ctx.Set("moduleName", opts.ModuleName)
 	ctx.Set("modulePath", opts.ModulePath)
 	ctx.Set("appName", opts.AppName)
 	ctx.Set("ownerName", opts.OwnerName)
 	ctx.Set("dependencies", opts.Dependencies)
-	ctx.Set("params", opts.Params)
 	ctx.Set("isIBC", opts.IsIBC)
 
 	// Used for proto package name
 	ctx.Set("formatOwnerName", xstrings.FormatUsername)
 
+	// Used to import the types of the dependency keeper methods
+	ctx.Set("mergeKeeperImports", mergeKeeperImports)
+
 	plushhelpers.ExtendPlushContext(ctx)
 	g.Transformer(plushgen.Transformer(ctx))
 	g.Transformer(genny.Replace("{{moduleName}}", opts.ModuleName))
@@ -157,7 +169,7 @@ func appModifyStargate(replacer placeholder.Replacer, opts *CreateOptions) genny
 			app.GetSubspace(%[2]vmoduletypes.ModuleName),
 			%[4]v
 			%[6]v)
-		%[2]vModule := %[2]vmodule.NewAppModule(appCodec, app.%[5]vKeeper)
+		%[2]vModule := %[2]vmodule.NewAppModule(appCodec, app.%[5]vKeeper, app.AccountKeeper, app.BankKeeper)
 
 		%[1]v`
 		replacement = fmt.Sprintf(
@@ -177,6 +189,12 @@ func appModifyStargate(replacer placeholder.Replacer, opts *CreateOptions) genny
 		replacement = fmt.Sprintf(template, module.PlaceholderSgAppAppModule, opts.ModuleName)
 		content = replacer.Replace(content, module.PlaceholderSgAppAppModule, replacement)
 
+		// App Module Simulation
+		template = `%[2]vModule,
+%[1]v`
+		replacement = fmt.Sprintf(template, module.PlaceholderSgAppAppModuleSimulation, opts.ModuleName)
+		content = replacer.Replace(content, module.PlaceholderSgAppAppModuleSimulation, replacement)
+
 		// Init genesis
 		template = `%[2]vmoduletypes.ModuleName,
 %[1]v`
@@ -16,4 +16,7 @@ var (
 
 	//go:embed genesistest/* genesistest/**/*
 	fsGenesisTest embed.FS
+
+	//go:embed dependencies/* dependencies/**/*
+	fsDependencies embed.FS
 )
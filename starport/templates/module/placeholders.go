@@ -12,10 +12,14 @@ const (
 	PlaceholderSgAppStoreKey            = "// this line is used by starport scaffolding # stargate/app/storeKey"
 	PlaceholderSgAppKeeperDefinition    = "// this line is used by starport scaffolding # stargate/app/keeperDefinition"
 	PlaceholderSgAppAppModule           = "// this line is used by starport scaffolding # stargate/app/appModule"
+	PlaceholderSgAppAppModuleSimulation = "// this line is used by starport scaffolding # stargate/app/appModuleSimulation"
 	PlaceholderSgAppInitGenesis         = "// this line is used by starport scaffolding # stargate/app/initGenesis"
+	PlaceholderSgAppBeginBlockers       = "// this line is used by starport scaffolding # stargate/app/beginBlockers"
+	PlaceholderSgAppEndBlockers         = "// this line is used by starport scaffolding # stargate/app/endBlockers"
 	PlaceholderSgAppParamSubspace       = "// this line is used by starport scaffolding # stargate/app/paramSubspace"
 	PlaceholderSgAppGovProposalHandlers = "// this line is used by starport scaffolding # stargate/app/govProposalHandlers"
 	PlaceholderSgAppScopedKeeper        = "// this line is used by starport scaffolding # stargate/app/scopedKeeper"
+	PlaceholderSgAppUpgradeHandler      = "// this line is used by starport scaffolding # stargate/app/upgradeHandler"
 	PlaceholderSgAppBeforeInitReturn    = "// this line is used by starport scaffolding # stargate/app/beforeInitReturn"
 	PlaceholderSgAppMaccPerms           = "// this line is used by starport scaffolding # stargate/app/maccPerms"
 
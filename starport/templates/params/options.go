@@ -0,0 +1,13 @@
+package params
+
+import "github.com/tendermint/starport/starport/templates/field"
+
+// Options represents the options to scaffold params in a module
+type Options struct {
+	AppName    string
+	AppPath    string
+	ModuleName string
+	ModulePath string
+	BinaryName string
+	Params     field.Fields
+}
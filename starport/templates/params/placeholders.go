@@ -0,0 +1,20 @@
+package params
+
+const (
+	PlaceholderParamsVars          = "// this line is used by starport scaffolding # params/vars"
+	PlaceholderParamsNewArgs       = "// this line is used by starport scaffolding # params/new/args"
+	PlaceholderParamsNewFields     = "// this line is used by starport scaffolding # params/new/fields"
+	PlaceholderParamsDefault       = "// this line is used by starport scaffolding # params/default"
+	PlaceholderParamsSetPairs      = "// this line is used by starport scaffolding # params/setPairs"
+	PlaceholderParamsValidate      = "// this line is used by starport scaffolding # params/validate"
+	PlaceholderParamsValidateFuncs = "// this line is used by starport scaffolding # params/validateFuncs"
+
+	PlaceholderKeeperParamsGet     = "// this line is used by starport scaffolding # keeper/params/get"
+	PlaceholderKeeperParamsGetters = "// this line is used by starport scaffolding # keeper/params/getters"
+	PlaceholderKeeperParamsTest    = "// this line is used by starport scaffolding # keeper/params/test"
+
+	PlaceholderProtoParams = "// this line is used by starport scaffolding # proto/params"
+
+	PlaceholderSpecParamsTable    = "<!-- this line is used by starport scaffolding # spec/params/table -->"
+	PlaceholderSpecParamsProposal = "<!-- this line is used by starport scaffolding # spec/params/proposal -->"
+)
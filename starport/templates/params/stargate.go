@@ -0,0 +1,326 @@
+package params
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/gobuffalo/genny"
+	"github.com/gobuffalo/plush"
+	"github.com/gobuffalo/plushgen"
+	"github.com/tendermint/starport/starport/pkg/placeholder"
+	"github.com/tendermint/starport/starport/pkg/xgenny"
+	"github.com/tendermint/starport/starport/templates/field/datatype"
+	"github.com/tendermint/starport/starport/templates/field/plushhelpers"
+)
+
+// protoFieldNumber matches the field numbers of a proto message
+var protoFieldNumber = regexp.MustCompile(`=\s*(\d+)\s*[;\[]`)
+
+// NewStargate returns the generator to scaffold params in a Stargate module
+func NewStargate(replacer placeholder.Replacer, opts *Options) (*genny.Generator, error) {
+	g := genny.New()
+
+	// Compute the default values first to report invalid rules before any modification
+	values := make([]paramValue, len(opts.Params))
+	for i, param := range opts.Params {
+		value, err := defaultValue(param)
+		if err != nil {
+			return g, err
+		}
+		values[i] = value
+	}
+
+	// The spec of the params is created with the first params of the module
+	specPath := filepath.Join(opts.AppPath, "x", opts.ModuleName, "spec/params.md")
+	if _, err := os.Stat(specPath); os.IsNotExist(err) {
+		template := xgenny.NewEmbedWalker(
+			fsSpec,
+			"spec/",
+			opts.AppPath,
+		)
+		if err := g.Box(template); err != nil {
+			return g, err
+		}
+	} else if err != nil {
+		return g, err
+	}
+
+	g.RunFn(protoModify(replacer, opts))
+	g.RunFn(typesModify(replacer, opts, values))
+	g.RunFn(keeperModify(replacer, opts))
+	g.RunFn(keeperTestModify(replacer, opts))
+	g.RunFn(specModify(replacer, opts, values))
+
+	ctx := plush.NewContext()
+	ctx.Set("moduleName", opts.ModuleName)
+	ctx.Set("binaryName", opts.BinaryName)
+
+	plushhelpers.ExtendPlushContext(ctx)
+	g.Transformer(plushgen.Transformer(ctx))
+	g.Transformer(genny.Replace("{{moduleName}}", opts.ModuleName))
+	return g, nil
+}
+
+// protoModify adds the params to the Params proto message
+func protoModify(replacer placeholder.Replacer, opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "proto", opts.ModuleName, "params.proto")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+		content := f.String()
+
+		// The new fields follow the last field of the message
+		index := 0
+		for _, match := range protoFieldNumber.FindAllStringSubmatch(content, -1) {
+			if n, _ := strconv.Atoi(match[1]); n > index {
+				index = n
+			}
+		}
+
+		for _, param := range opts.Params {
+			index++
+
+			// The yaml tag is merged with the options of the type
+			yamlTag := fmt.Sprintf(`(gogoproto.moretags) = "yaml:\"%s\""`, param.Name.Snake)
+			protoType := param.ProtoType(index)
+			if strings.HasSuffix(protoType, "]") {
+				protoType = fmt.Sprintf("%s, %s]", strings.TrimSuffix(protoType, "]"), yamlTag)
+			} else {
+				protoType = fmt.Sprintf("%s [%s]", protoType, yamlTag)
+			}
+
+			template := `%[2]v;
+  %[1]v`
+			replacement := fmt.Sprintf(template, PlaceholderProtoParams, protoType)
+			content = replacer.Replace(content, PlaceholderProtoParams, replacement)
+
+			// Ensure the proto imports of the type are present
+			for _, protoImport := range param.ProtoImports() {
+				importLine := fmt.Sprintf(`import "%s";`, protoImport)
+				if !strings.Contains(content, importLine) {
+					content = strings.Replace(content, `import "gogoproto/gogo.proto";`, `import "gogoproto/gogo.proto";
+`+importLine, 1)
+				}
+			}
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+// typesModify adds the params to the Params type with their default value and validation
+func typesModify(replacer placeholder.Replacer, opts *Options, values []paramValue) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "types/params.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+		content := ensureImport(f.String(), `"fmt"`)
+
+		for i, param := range opts.Params {
+			todo := ""
+			if _, ok := param.Rules.Get("default"); !ok {
+				todo = "\n\t// TODO: Determine the default value"
+			}
+			templateVars := `var (
+	Key%[2]v = []byte("%[2]v")%[4]v
+	Default%[2]v %[3]v = %[5]v
+)
+
+%[1]v`
+			replacementVars := fmt.Sprintf(
+				templateVars,
+				PlaceholderParamsVars,
+				param.Name.UpperCamel,
+				param.DataType(),
+				todo,
+				values[i].Go,
+			)
+			content = replacer.Replace(content, PlaceholderParamsVars, replacementVars)
+
+			templateArgs := `%[2]v %[3]v,
+	%[1]v`
+			replacementArgs := fmt.Sprintf(templateArgs, PlaceholderParamsNewArgs, param.Name.LowerCamel, param.DataType())
+			content = replacer.Replace(content, PlaceholderParamsNewArgs, replacementArgs)
+
+			templateFields := `%[2]v: %[3]v,
+		%[1]v`
+			replacementFields := fmt.Sprintf(templateFields, PlaceholderParamsNewFields, param.Name.UpperCamel, param.Name.LowerCamel)
+			content = replacer.Replace(content, PlaceholderParamsNewFields, replacementFields)
+
+			templateDefault := `Default%[2]v,
+		%[1]v`
+			replacementDefault := fmt.Sprintf(templateDefault, PlaceholderParamsDefault, param.Name.UpperCamel)
+			content = replacer.Replace(content, PlaceholderParamsDefault, replacementDefault)
+
+			templateSetPairs := `paramtypes.NewParamSetPair(Key%[2]v, &p.%[2]v, validate%[2]v),
+		%[1]v`
+			replacementSetPairs := fmt.Sprintf(templateSetPairs, PlaceholderParamsSetPairs, param.Name.UpperCamel)
+			content = replacer.Replace(content, PlaceholderParamsSetPairs, replacementSetPairs)
+
+			templateValidate := `if err := validate%[2]v(p.%[2]v); err != nil {
+		return err
+	}
+
+	%[1]v`
+			replacementValidate := fmt.Sprintf(templateValidate, PlaceholderParamsValidate, param.Name.UpperCamel)
+			content = replacer.Replace(content, PlaceholderParamsValidate, replacementValidate)
+
+			templateValidateFunc := `// validate%[2]v validates the %[2]v param
+func validate%[2]v(v interface{}) error {
+	%[3]v, ok := v.(%[4]v)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %%T", v)
+	}
+
+	%[5]v
+
+	return nil
+}
+
+%[1]v`
+			replacementValidateFunc := fmt.Sprintf(
+				templateValidateFunc,
+				PlaceholderParamsValidateFuncs,
+				param.Name.UpperCamel,
+				param.Name.LowerCamel,
+				param.DataType(),
+				validation(param),
+			)
+			content = replacer.Replace(content, PlaceholderParamsValidateFuncs, replacementValidateFunc)
+
+			// Ensure the packages used by the default value and the validation are imported
+			if _, ok := param.Rules.Get("regex"); ok {
+				content = ensureImport(content, `"regexp"`)
+			}
+			switch param.DatatypeName {
+			case datatype.Coin, datatype.Coins, datatype.CoinSliceAlias:
+				content = ensureImport(content, `sdk "github.com/cosmos/cosmos-sdk/types"`)
+			}
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+// keeperModify adds the getters of the params to the keeper
+func keeperModify(replacer placeholder.Replacer, opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "keeper/params.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+		content := f.String()
+
+		for _, param := range opts.Params {
+			templateGet := `k.%[2]v(ctx),
+		%[1]v`
+			replacementGet := fmt.Sprintf(templateGet, PlaceholderKeeperParamsGet, param.Name.UpperCamel)
+			content = replacer.Replace(content, PlaceholderKeeperParamsGet, replacementGet)
+
+			templateGetter := `// %[2]v returns the %[2]v param
+func (k Keeper) %[2]v(ctx sdk.Context) (res %[3]v) {
+	k.paramstore.Get(ctx, types.Key%[2]v, &res)
+	return
+}
+
+%[1]v`
+			replacementGetter := fmt.Sprintf(templateGetter, PlaceholderKeeperParamsGetters, param.Name.UpperCamel, param.DataType())
+			content = replacer.Replace(content, PlaceholderKeeperParamsGetters, replacementGetter)
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+// keeperTestModify checks the getters of the params in the keeper tests
+func keeperTestModify(replacer placeholder.Replacer, opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "keeper/params_test.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+		content := f.String()
+
+		for _, param := range opts.Params {
+			template := `require.EqualValues(t, params.%[2]v, k.%[2]v(ctx))
+	%[1]v`
+			replacement := fmt.Sprintf(template, PlaceholderKeeperParamsTest, param.Name.UpperCamel)
+			content = replacer.Replace(content, PlaceholderKeeperParamsTest, replacement)
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+// specModify documents the params and the proposals to change them
+func specModify(replacer placeholder.Replacer, opts *Options, values []paramValue) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "spec/params.md")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+		content := f.String()
+
+		for i, param := range opts.Params {
+			templateTable := "| %[2]v | `%[3]v` | `%[4]v` | %[5]v |\n%[1]v"
+			replacementTable := fmt.Sprintf(
+				templateTable,
+				PlaceholderSpecParamsTable,
+				param.Name.UpperCamel,
+				param.DataType(),
+				values[i].JSON,
+				rulesDescription(param),
+			)
+			content = replacer.Replace(content, PlaceholderSpecParamsTable, replacementTable)
+
+			templateProposal := "### %[2]v\n\n```json" + `
+{
+  "title": "Update %[2]v",
+  "description": "Update the %[2]v param of the %[3]v module",
+  "changes": [
+    {
+      "subspace": "%[3]v",
+      "key": "%[2]v",
+      "value": %[4]v
+    }
+  ],
+  "deposit": "10000000stake"
+}
+` + "```\n\n%[1]v"
+			replacementProposal := fmt.Sprintf(
+				templateProposal,
+				PlaceholderSpecParamsProposal,
+				param.Name.UpperCamel,
+				opts.ModuleName,
+				values[i].JSON,
+			)
+			content = replacer.Replace(content, PlaceholderSpecParamsProposal, replacementProposal)
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+// ensureImport adds the import to the Go source if it is not already imported
+func ensureImport(content, goImport string) string {
+	if strings.Contains(content, goImport) {
+		return content
+	}
+	return strings.Replace(content, "import (", "import (\n\t"+goImport, 1)
+}
@@ -0,0 +1,10 @@
+package params
+
+import (
+	"embed"
+)
+
+var (
+	//go:embed spec/* spec/**/*
+	fsSpec embed.FS
+)
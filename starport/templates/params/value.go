@@ -0,0 +1,169 @@
+package params
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/tendermint/starport/starport/templates/field"
+	"github.com/tendermint/starport/starport/templates/field/datatype"
+)
+
+// paramValue represents the default value of a param in the scaffolded code
+type paramValue struct {
+	// Go is the Go expression of the value
+	Go string
+
+	// JSON is the value encoded in JSON as expected by a param-change proposal
+	JSON string
+}
+
+// defaultValue returns the default value of the param satisfying its validation rules
+func defaultValue(param field.Field) (paramValue, error) {
+	defaultRule, hasDefault := param.Rules.Get(field.RuleDefault)
+	_, nonEmpty := param.Rules.Get(field.RuleNonEmpty)
+
+	switch param.DatatypeName {
+	case datatype.String:
+		value := param.Name.Snake
+		if hasDefault {
+			value = defaultRule
+		}
+		if expr, ok := param.Rules.Get(field.RuleRegex); ok && !regexp.MustCompile(expr).MatchString(value) {
+			return paramValue{}, fmt.Errorf(
+				"the default value %q of %s doesn't match %s, provide a default value with the %s rule",
+				value,
+				param.Name.Original,
+				expr,
+				field.RuleDefault,
+			)
+		}
+		return paramValue{Go: strconv.Quote(value), JSON: strconv.Quote(value)}, nil
+	case datatype.Bool:
+		value := "false"
+		if hasDefault {
+			b, _ := strconv.ParseBool(defaultRule)
+			value = strconv.FormatBool(b)
+		}
+		return paramValue{Go: value, JSON: value}, nil
+	case datatype.Int, datatype.Uint:
+		value := numberDefault(param.Rules)
+		if param.DatatypeName == datatype.Uint {
+			// uint64 values are encoded as strings in JSON
+			return paramValue{Go: value, JSON: strconv.Quote(value)}, nil
+		}
+		return paramValue{Go: value, JSON: value}, nil
+	case datatype.StringSlice, datatype.StringSliceAlias:
+		if nonEmpty {
+			value := strconv.Quote(param.Name.Snake)
+			return paramValue{Go: fmt.Sprintf("[]string{%s}", value), JSON: fmt.Sprintf("[%s]", value)}, nil
+		}
+	case datatype.IntSlice, datatype.IntSliceAlias:
+		if nonEmpty {
+			return paramValue{Go: "[]int32{0}", JSON: "[0]"}, nil
+		}
+	case datatype.UintSlice, datatype.UintSliceAlias:
+		if nonEmpty {
+			return paramValue{Go: "[]uint64{0}", JSON: `["0"]`}, nil
+		}
+	case datatype.Coin:
+		amount := "0"
+		if nonEmpty {
+			amount = "1"
+		}
+		return paramValue{
+			Go:   fmt.Sprintf("sdk.NewInt64Coin(sdk.DefaultBondDenom, %s)", amount),
+			JSON: fmt.Sprintf(`{"denom":"stake","amount":"%s"}`, amount),
+		}, nil
+	case datatype.Coins, datatype.CoinSliceAlias:
+		if nonEmpty {
+			return paramValue{
+				Go:   "sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1))",
+				JSON: `[{"denom":"stake","amount":"1"}]`,
+			}, nil
+		}
+	default:
+		return paramValue{}, fmt.Errorf("the type %s of %s can't be used for a param", param.Datatype, param.Name.Original)
+	}
+
+	// empty list
+	return paramValue{Go: "nil", JSON: "[]"}, nil
+}
+
+// numberDefault returns the default value of a number param
+// the value is zero if it satisfies the min and max rules
+func numberDefault(rules field.Rules) string {
+	if value, ok := rules.Get(field.RuleDefault); ok {
+		return value
+	}
+	if minValue, ok := rules.Get(field.RuleMin); ok && !strings.HasPrefix(minValue, "-") && minValue != "0" {
+		return minValue
+	}
+	if maxValue, ok := rules.Get(field.RuleMax); ok && strings.HasPrefix(maxValue, "-") {
+		return maxValue
+	}
+	return "0"
+}
+
+// validation returns the code validating the value of the param from its rules
+func validation(param field.Field) string {
+	var checks []string
+	name := param.Name.LowerCamel
+
+	switch param.DatatypeName {
+	case datatype.Coin, datatype.Coins, datatype.CoinSliceAlias:
+		checks = append(checks, fmt.Sprintf(`if err := %s.Validate(); err != nil {
+		return err
+	}`, name))
+	}
+
+	for _, rule := range param.Rules {
+		switch rule.Name {
+		case field.RuleMin:
+			checks = append(checks, fmt.Sprintf(`if %[1]v < %[2]v {
+		return fmt.Errorf("%[3]v must be greater than or equal to %[2]v: %%d", %[1]v)
+	}`, name, rule.Value, param.Name.UpperCamel))
+		case field.RuleMax:
+			checks = append(checks, fmt.Sprintf(`if %[1]v > %[2]v {
+		return fmt.Errorf("%[3]v must be lower than or equal to %[2]v: %%d", %[1]v)
+	}`, name, rule.Value, param.Name.UpperCamel))
+		case field.RuleRegex:
+			checks = append(checks, fmt.Sprintf(`if !regexp.MustCompile(%[2]q).MatchString(%[1]v) {
+		return fmt.Errorf("%[3]v must match %%s: %%s", %[2]q, %[1]v)
+	}`, name, rule.Value, param.Name.UpperCamel))
+		case field.RuleNonEmpty:
+			condition := fmt.Sprintf("len(%s) == 0", name)
+			if param.DatatypeName == datatype.Coin {
+				condition = fmt.Sprintf("%s.IsZero()", name)
+			}
+			checks = append(checks, fmt.Sprintf(`if %[1]v {
+		return fmt.Errorf("%[2]v can't be empty")
+	}`, condition, param.Name.UpperCamel))
+		}
+	}
+
+	if len(checks) == 0 {
+		return fmt.Sprintf(`// TODO implement validation
+	_ = %s`, name)
+	}
+	return strings.Join(checks, "\n\n\t")
+}
+
+// rulesDescription returns the description of the validation rules of the param
+func rulesDescription(param field.Field) string {
+	var rules []string
+	for _, rule := range param.Rules {
+		switch rule.Name {
+		case field.RuleDefault:
+		case field.RuleNonEmpty:
+			rules = append(rules, rule.Name)
+		default:
+			rules = append(rules, fmt.Sprintf("%s=`%s`", rule.Name, rule.Value))
+		}
+	}
+	if len(rules) == 0 {
+		return "-"
+	}
+	return strings.Join(rules, ", ")
+}
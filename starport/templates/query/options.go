@@ -3,6 +3,21 @@ package query
 import (
 	"github.com/tendermint/starport/starport/pkg/multiformatname"
 	"github.com/tendermint/starport/starport/templates/field"
+	"github.com/tendermint/starport/starport/templates/field/datatype"
+)
+
+const (
+	// StorageList is the storage of a type scaffolded as a list
+	StorageList = "list"
+	// StorageMap is the storage of a type scaffolded as a map
+	StorageMap = "map"
+	// StorageSingleton is the storage of a type scaffolded as a singleton
+	StorageSingleton = "singleton"
+
+	// HTTPMethodGet is the default HTTP method of the REST route of a query
+	HTTPMethodGet = "get"
+	// HTTPMethodPost is the HTTP method of a REST route reading the request from the body
+	HTTPMethodPost = "post"
 )
 
 // Options ...
@@ -17,4 +32,45 @@ type Options struct {
 	ResFields   field.Fields
 	ReqFields   field.Fields
 	Paginated   bool
+
+	// RestRoute is the route template of the REST endpoint, the default route is used if empty
+	RestRoute string
+
+	// HTTPMethod is the HTTP method of the REST endpoint
+	HTTPMethod string
+
+	// StoredFields are the response fields returning types stored in the module
+	StoredFields []StoredField
+}
+
+// StoredField represents a response field returning a type stored in the module
+type StoredField struct {
+	Field field.Field
+
+	// Storage is the storage of the type: list, map or singleton
+	Storage string
+
+	// MapKey is the expression of the key of the values when they are returned in a map field
+	MapKey string
+
+	// Paginated is true if the values are fetched with the pagination of the request
+	Paginated bool
+}
+
+// KeyPrefix returns the constant of the prefix of the store of the type
+func (s StoredField) KeyPrefix() string {
+	if s.Storage == StorageMap {
+		return s.Field.Datatype + "KeyPrefix"
+	}
+	return s.Field.Datatype + "Key"
+}
+
+// IsMap returns true if the values are returned in a map field
+func (s StoredField) IsMap() bool {
+	return s.Field.DatatypeName == datatype.CustomMap
+}
+
+// IsSingle returns true if a single value is returned
+func (s StoredField) IsSingle() bool {
+	return s.Field.DatatypeName == datatype.Custom
 }
@@ -2,6 +2,7 @@ package query
 
 import (
 	"embed"
+	"strings"
 
 	"github.com/gobuffalo/genny"
 	"github.com/gobuffalo/packd"
@@ -29,6 +30,16 @@ func Box(box packd.Walker, opts *Options, g *genny.Generator) error {
 	ctx.Set("ReqFields", opts.ReqFields)
 	ctx.Set("ResFields", opts.ResFields)
 	ctx.Set("Paginated", opts.Paginated)
+	ctx.Set("StoredFields", opts.StoredFields)
+
+	// Imports of the keeper fetching the stored types
+	var storeImports, strconvImport bool
+	for _, stored := range opts.StoredFields {
+		storeImports = storeImports || stored.Paginated
+		strconvImport = strconvImport || (stored.IsMap() && strings.Contains(stored.MapKey, "strconv."))
+	}
+	ctx.Set("storeImports", storeImports)
+	ctx.Set("strconvImport", strconvImport)
 
 	plushhelpers.ExtendPlushContext(ctx)
 	g.Transformer(plushgen.Transformer(ctx))
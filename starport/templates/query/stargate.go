@@ -36,9 +36,9 @@ func protoQueryModify(replacer placeholder.Replacer, opts *Options) genny.RunFn
 		}
 
 		// RPC service
-		templateRPC := `// Queries a list of %[3]v items.
+		templateRPC := `// %[3]v
 	rpc %[2]v(Query%[2]vRequest) returns (Query%[2]vResponse) {
-		option (google.api.http).get = "/%[4]v/%[5]v/%[6]v/%[3]v";
+		%[4]v
 	}
 
 %[1]v`
@@ -46,10 +46,8 @@ func protoQueryModify(replacer placeholder.Replacer, opts *Options) genny.RunFn
 			templateRPC,
 			Placeholder2,
 			opts.QueryName.UpperCamel,
-			opts.QueryName.LowerCamel,
-			opts.OwnerName,
-			opts.AppName,
-			opts.ModuleName,
+			opts.Description,
+			httpRule(opts),
 		)
 		content := replacer.Replace(f.String(), Placeholder2, replacementRPC)
 
@@ -59,7 +57,7 @@ func protoQueryModify(replacer placeholder.Replacer, opts *Options) genny.RunFn
 			reqFields += fmt.Sprintf("  %s;\n", field.ProtoType(i+1))
 		}
 		if opts.Paginated {
-			reqFields += fmt.Sprintf("cosmos.base.query.v1beta1.PageRequest pagination = %d;\n", len(opts.ReqFields)+1)
+			reqFields += fmt.Sprintf("  cosmos.base.query.v1beta1.PageRequest pagination = %d;\n", len(opts.ReqFields)+1)
 		}
 
 		// Fields for response
@@ -68,7 +66,7 @@ func protoQueryModify(replacer placeholder.Replacer, opts *Options) genny.RunFn
 			resFields += fmt.Sprintf("  %s;\n", field.ProtoType(i+1))
 		}
 		if opts.Paginated {
-			resFields += fmt.Sprintf("cosmos.base.query.v1beta1.PageResponse pagination = %d;\n", len(opts.ResFields)+1)
+			resFields += fmt.Sprintf("  cosmos.base.query.v1beta1.PageResponse pagination = %d;\n", len(opts.ResFields)+1)
 		}
 
 		// Ensure custom types are imported
@@ -110,6 +108,27 @@ message Query%[2]vResponse {
 	}
 }
 
+// httpRule returns the option annotating the RPC with the REST route of the query
+func httpRule(opts *Options) string {
+	route := opts.RestRoute
+	if route == "" {
+		route = fmt.Sprintf(
+			"/%[1]v/%[2]v/%[3]v/%[4]v",
+			opts.OwnerName,
+			opts.AppName,
+			opts.ModuleName,
+			opts.QueryName.LowerCamel,
+		)
+	}
+	if opts.HTTPMethod == HTTPMethodPost {
+		return fmt.Sprintf(`option (google.api.http) = {
+			post: "%s"
+			body: "*"
+		};`, route)
+	}
+	return fmt.Sprintf(`option (google.api.http).get = "%s";`, route)
+}
+
 func cliQueryModify(replacer placeholder.Replacer, opts *Options) genny.RunFn {
 	return func(r *genny.Runner) error {
 		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "client/cli/query.go")
@@ -0,0 +1,53 @@
+package list
+
+import (
+	"fmt"
+
+	"github.com/gobuffalo/genny"
+	"github.com/tendermint/starport/starport/pkg/placeholder"
+	"github.com/tendermint/starport/starport/templates/typed"
+)
+
+func simulationModify(replacer placeholder.Replacer, opts *typed.Options, g *genny.Generator) {
+	var signer string
+	if !opts.NoMessage {
+		signer = fmt.Sprintf("%s: sample.AccAddress(),\n", opts.MsgSigner.UpperCamel)
+		g.RunFn(typed.ModuleSimulationMsgModify(
+			replacer,
+			opts.AppPath,
+			opts.ModuleName,
+			opts.TypeName,
+			"Create", "Update", "Delete",
+		))
+	}
+
+	templateGenesisState := `%[1]vList: []types.%[1]v{
+	{
+		Id: 0,
+		%[2]v},
+	{
+		Id: 1,
+		%[2]v},
+},
+%[1]vCount: 2,`
+	g.RunFn(typed.ModuleSimulationGenesisModify(
+		replacer,
+		opts.AppPath,
+		opts.ModuleName,
+		fmt.Sprintf(templateGenesisState, opts.TypeName.UpperCamel, signer),
+	))
+
+	templateDecoder := `case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.%[1]vCountKey)):
+	return fmt.Sprintf("%%d\n%%d", binary.BigEndian.Uint64(kvA.Value), binary.BigEndian.Uint64(kvB.Value))
+case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.%[1]vKey)):
+	var %[2]vA, %[2]vB types.%[1]v
+	cdc.MustUnmarshal(kvA.Value, &%[2]vA)
+	cdc.MustUnmarshal(kvB.Value, &%[2]vB)
+	return fmt.Sprintf("%%v\n%%v", %[2]vA, %[2]vB)`
+	g.RunFn(typed.SimulationDecoderModify(
+		replacer,
+		opts.AppPath,
+		opts.ModuleName,
+		fmt.Sprintf(templateDecoder, opts.TypeName.UpperCamel, opts.TypeName.LowerCamel),
+	))
+}
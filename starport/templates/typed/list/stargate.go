@@ -46,6 +46,9 @@ func NewStargate(replacer placeholder.Replacer, opts *typed.Options) (*genny.Gen
 	// Genesis modifications
 	genesisModify(replacer, opts, g)
 
+	// Simulation modifications
+	simulationModify(replacer, opts, g)
+
 	if !opts.NoMessage {
 		// Modifications for new messages
 		g.RunFn(handlerModify(replacer, opts))
@@ -0,0 +1,57 @@
+package maptype
+
+import (
+	"fmt"
+
+	"github.com/gobuffalo/genny"
+	"github.com/tendermint/starport/starport/pkg/placeholder"
+	"github.com/tendermint/starport/starport/templates/typed"
+)
+
+func simulationModify(replacer placeholder.Replacer, opts *typed.Options, g *genny.Generator) {
+	var signer string
+	if !opts.NoMessage {
+		signer = fmt.Sprintf("%s: sample.AccAddress(),\n", opts.MsgSigner.UpperCamel)
+		g.RunFn(typed.ModuleSimulationMsgModify(
+			replacer,
+			opts.AppPath,
+			opts.ModuleName,
+			opts.TypeName,
+			"Create", "Update", "Delete",
+		))
+	}
+
+	// Create a list of two different indexes to use as sample
+	sampleIndexes := make([]string, 2)
+	for i := 0; i < 2; i++ {
+		sampleIndexes[i] = signer
+		for _, index := range opts.Indexes {
+			sampleIndexes[i] += index.GenesisArgs(i)
+		}
+	}
+
+	templateGenesisState := `%[1]vList: []types.%[1]v{
+	{
+		%[2]v},
+	{
+		%[3]v},
+},`
+	g.RunFn(typed.ModuleSimulationGenesisModify(
+		replacer,
+		opts.AppPath,
+		opts.ModuleName,
+		fmt.Sprintf(templateGenesisState, opts.TypeName.UpperCamel, sampleIndexes[0], sampleIndexes[1]),
+	))
+
+	templateDecoder := `case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.%[1]vKeyPrefix)):
+	var %[2]vA, %[2]vB types.%[1]v
+	cdc.MustUnmarshal(kvA.Value, &%[2]vA)
+	cdc.MustUnmarshal(kvB.Value, &%[2]vB)
+	return fmt.Sprintf("%%v\n%%v", %[2]vA, %[2]vB)`
+	g.RunFn(typed.SimulationDecoderModify(
+		replacer,
+		opts.AppPath,
+		opts.ModuleName,
+		fmt.Sprintf(templateDecoder, opts.TypeName.UpperCamel, opts.TypeName.LowerCamel),
+	))
+}
@@ -17,6 +17,7 @@ type Options struct {
 	Fields     field.Fields
 	Indexes    field.Fields
 	NoMessage  bool
+	Events     bool
 	IsIBC      bool
 }
 
@@ -20,4 +20,10 @@ const (
 	PlaceholderGenesisTypesValidate = "// this line is used by starport scaffolding # genesis/types/validate"
 	PlaceholderGenesisModuleInit    = "// this line is used by starport scaffolding # genesis/module/init"
 	PlaceholderGenesisModuleExport  = "// this line is used by starport scaffolding # genesis/module/export"
+
+	// Simulation
+	PlaceholderSimappConst        = "// this line is used by starport scaffolding # simapp/module/const"
+	PlaceholderSimappGenesisState = "// this line is used by starport scaffolding # simapp/module/genesisState"
+	PlaceholderSimappOperation    = "// this line is used by starport scaffolding # simapp/module/operation"
+	PlaceholderSimulationDecoder  = "// this line is used by starport scaffolding # simulation/decoder"
 )
@@ -0,0 +1,115 @@
+package typed
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/gobuffalo/genny"
+	"github.com/tendermint/starport/starport/pkg/multiformatname"
+	"github.com/tendermint/starport/starport/pkg/placeholder"
+)
+
+// ModuleSimulationMsgModify adds the weighted operations to simulate the messages of typeName
+// to the module simulation. msgs are the prefixes of the message names like "Create", the message
+// named after typeName is simulated when no prefix is given.
+func ModuleSimulationMsgModify(
+	replacer placeholder.Replacer,
+	appPath,
+	moduleName string,
+	typeName multiformatname.Name,
+	msgs ...string,
+) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(appPath, "x", moduleName, "module_simulation.go")
+		f, err := r.Disk.Find(path)
+		if os.IsNotExist(err) {
+			// Skip modification if the module doesn't support simulation
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if len(msgs) == 0 {
+			msgs = append(msgs, "")
+		}
+
+		content := f.String()
+		for _, msg := range msgs {
+			opName := typeName.Snake
+			if msg != "" {
+				opName = fmt.Sprintf("%s_%s", strings.ToLower(msg), typeName.Snake)
+			}
+
+			templateConst := `opWeightMsg%[2]v%[3]v = "op_weight_msg_%[4]v"
+	// TODO: Determine the simulation weight value
+	defaultWeightMsg%[2]v%[3]v int = 100
+
+	%[1]v`
+			replacementConst := fmt.Sprintf(templateConst, PlaceholderSimappConst, msg, typeName.UpperCamel, opName)
+			content = replacer.Replace(content, PlaceholderSimappConst, replacementConst)
+
+			templateOp := `var weightMsg%[2]v%[3]v int
+	simState.AppParams.GetOrGenerate(simState.Cdc, opWeightMsg%[2]v%[3]v, &weightMsg%[2]v%[3]v, nil,
+		func(_ *rand.Rand) {
+			weightMsg%[2]v%[3]v = defaultWeightMsg%[2]v%[3]v
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsg%[2]v%[3]v,
+		%[4]vsimulation.SimulateMsg%[2]v%[3]v(am.accountKeeper, am.bankKeeper, am.keeper),
+	))
+
+	%[1]v`
+			replacementOp := fmt.Sprintf(templateOp, PlaceholderSimappOperation, msg, typeName.UpperCamel, moduleName)
+			content = replacer.Replace(content, PlaceholderSimappOperation, replacementOp)
+		}
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+// ModuleSimulationGenesisModify adds genesisState to the randomized genesis state of the module simulation.
+func ModuleSimulationGenesisModify(replacer placeholder.Replacer, appPath, moduleName, genesisState string) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(appPath, "x", moduleName, "module_simulation.go")
+		f, err := r.Disk.Find(path)
+		if os.IsNotExist(err) {
+			// Skip modification if the module doesn't support simulation
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		replacement := fmt.Sprintf("%s\n%s", genesisState, PlaceholderSimappGenesisState)
+		content := replacer.Replace(f.String(), PlaceholderSimappGenesisState, replacement)
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+// SimulationDecoderModify adds the decoderCase switch case to the store decoder of the module simulation.
+func SimulationDecoderModify(replacer placeholder.Replacer, appPath, moduleName, decoderCase string) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(appPath, "x", moduleName, "simulation/decoder.go")
+		f, err := r.Disk.Find(path)
+		if os.IsNotExist(err) {
+			// Skip modification if the module doesn't support simulation
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		replacement := fmt.Sprintf("%s\n%s", decoderCase, PlaceholderSimulationDecoder)
+		content := replacer.Replace(f.String(), PlaceholderSimulationDecoder, replacement)
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
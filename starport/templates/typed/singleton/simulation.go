@@ -0,0 +1,33 @@
+package singleton
+
+import (
+	"fmt"
+
+	"github.com/gobuffalo/genny"
+	"github.com/tendermint/starport/starport/pkg/placeholder"
+	"github.com/tendermint/starport/starport/templates/typed"
+)
+
+func simulationModify(replacer placeholder.Replacer, opts *typed.Options, g *genny.Generator) {
+	if !opts.NoMessage {
+		g.RunFn(typed.ModuleSimulationMsgModify(
+			replacer,
+			opts.AppPath,
+			opts.ModuleName,
+			opts.TypeName,
+			"Create", "Update", "Delete",
+		))
+	}
+
+	templateDecoder := `case bytes.HasPrefix(kvA.Key, types.KeyPrefix(types.%[1]vKey)):
+	var %[2]vA, %[2]vB types.%[1]v
+	cdc.MustUnmarshal(kvA.Value, &%[2]vA)
+	cdc.MustUnmarshal(kvB.Value, &%[2]vB)
+	return fmt.Sprintf("%%v\n%%v", %[2]vA, %[2]vB)`
+	g.RunFn(typed.SimulationDecoderModify(
+		replacer,
+		opts.AppPath,
+		opts.ModuleName,
+		fmt.Sprintf(templateDecoder, opts.TypeName.UpperCamel, opts.TypeName.LowerCamel),
+	))
+}
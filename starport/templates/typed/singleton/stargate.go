@@ -49,6 +49,9 @@ func NewStargate(replacer placeholder.Replacer, opts *typed.Options) (*genny.Gen
 	g.RunFn(genesisTestsModify(replacer, opts))
 	g.RunFn(genesisTypesTestsModify(replacer, opts))
 
+	// Simulation modifications
+	simulationModify(replacer, opts, g)
+
 	// Modifications for new messages
 	if !opts.NoMessage {
 		g.RunFn(protoTxModify(replacer, opts))
@@ -24,6 +24,7 @@ func Box(box packd.Walker, opts *Options, g *genny.Generator) error {
 	ctx.Set("Fields", opts.Fields)
 	ctx.Set("Indexes", opts.Indexes)
 	ctx.Set("NoMessage", opts.NoMessage)
+	ctx.Set("Events", opts.Events)
 	ctx.Set("strconv", func() bool {
 		strconv := false
 		for _, field := range opts.Fields {
@@ -0,0 +1,25 @@
+package upgrade
+
+import "fmt"
+
+// Options represents the options to scaffold a chain upgrade
+type Options struct {
+	AppName     string
+	AppPath     string
+	ModuleName  string
+	ModulePath  string
+	UpgradeName string
+
+	// FromVersion is the current consensus version of the module
+	FromVersion uint64
+}
+
+// ToVersion returns the consensus version of the module after the upgrade
+func (opts *Options) ToVersion() uint64 {
+	return opts.FromVersion + 1
+}
+
+// MigrationPackage returns the name of the package containing the store migration
+func (opts *Options) MigrationPackage() string {
+	return fmt.Sprintf("v%d", opts.ToVersion())
+}
@@ -0,0 +1,6 @@
+package upgrade
+
+const (
+	PlaceholderModuleMigration = "// this line is used by starport scaffolding # module/migration"
+	PlaceholderKeeperMigrator  = "// this line is used by starport scaffolding # keeper/migrator"
+)
@@ -0,0 +1,13 @@
+package upgrade
+
+import (
+	"embed"
+)
+
+var (
+	//go:embed stargate/* stargate/**/*
+	fsStargate embed.FS
+
+	//go:embed migrator/* migrator/**/*
+	fsMigrator embed.FS
+)
@@ -0,0 +1,167 @@
+package upgrade
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/gobuffalo/genny"
+	"github.com/gobuffalo/plush"
+	"github.com/gobuffalo/plushgen"
+	"github.com/tendermint/starport/starport/pkg/placeholder"
+	"github.com/tendermint/starport/starport/pkg/xgenny"
+	"github.com/tendermint/starport/starport/templates/field/plushhelpers"
+	"github.com/tendermint/starport/starport/templates/module"
+)
+
+const consensusVersionFunc = "func (AppModule) ConsensusVersion() uint64 { return %d }"
+
+// NewStargate returns the generator to scaffold an upgrade handler and a store migration for a module
+func NewStargate(replacer placeholder.Replacer, opts *Options) (*genny.Generator, error) {
+	var (
+		g        = genny.New()
+		template = xgenny.NewEmbedWalker(
+			fsStargate,
+			"stargate/",
+			opts.AppPath,
+		)
+		migratorTemplate = xgenny.NewEmbedWalker(
+			fsMigrator,
+			"migrator/",
+			opts.AppPath,
+		)
+	)
+
+	// The migrator is created with the first upgrade of the module
+	migratorPath := filepath.Join(opts.AppPath, "x", opts.ModuleName, "keeper/migrations.go")
+	if _, err := os.Stat(migratorPath); os.IsNotExist(err) {
+		if err := g.Box(migratorTemplate); err != nil {
+			return g, err
+		}
+	} else if err != nil {
+		return g, err
+	}
+	if err := g.Box(template); err != nil {
+		return g, err
+	}
+
+	g.RunFn(appModify(replacer, opts))
+	g.RunFn(moduleModify(replacer, opts))
+	g.RunFn(keeperModify(replacer, opts))
+
+	ctx := plush.NewContext()
+	ctx.Set("moduleName", opts.ModuleName)
+	ctx.Set("modulePath", opts.ModulePath)
+	ctx.Set("appName", opts.AppName)
+	ctx.Set("upgradeName", opts.UpgradeName)
+	ctx.Set("fromVersion", opts.FromVersion)
+	ctx.Set("toVersion", opts.ToVersion())
+	ctx.Set("migrationPackage", opts.MigrationPackage())
+
+	plushhelpers.ExtendPlushContext(ctx)
+	g.Transformer(plushgen.Transformer(ctx))
+	g.Transformer(genny.Replace("{{moduleName}}", opts.ModuleName))
+	g.Transformer(genny.Replace("{{migrationVersion}}", opts.MigrationPackage()))
+	return g, nil
+}
+
+// appModify registers the upgrade handler in app.go if it is not already registered
+func appModify(replacer placeholder.Replacer, opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, module.PathAppGo)
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		// Modules upgraded together share the same handler
+		content := f.String()
+		if strings.Contains(content, fmt.Sprintf("SetUpgradeHandler(%q", opts.UpgradeName)) {
+			return nil
+		}
+
+		template := `app.UpgradeKeeper.SetUpgradeHandler(%[2]q, func(ctx sdk.Context, plan upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		return app.mm.RunMigrations(ctx, app.configurator, vm)
+	})
+	%[1]v`
+		replacement := fmt.Sprintf(template, module.PlaceholderSgAppUpgradeHandler, opts.UpgradeName)
+		content = replacer.Replace(content, module.PlaceholderSgAppUpgradeHandler, replacement)
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+// moduleModify bumps the consensus version of the module and registers the store migration
+func moduleModify(replacer placeholder.Replacer, opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "module.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		content := replacer.Replace(
+			f.String(),
+			fmt.Sprintf(consensusVersionFunc, opts.FromVersion),
+			fmt.Sprintf(consensusVersionFunc, opts.ToVersion()),
+		)
+
+		template := `if err := cfg.RegisterMigration(types.ModuleName, %[2]v, keeper.NewMigrator(am.keeper).Migrate%[2]vto%[3]v); err != nil {
+		panic(fmt.Errorf("failed to register the %[4]v module migration to version %[3]v: %%w", err))
+	}
+	%[1]v`
+		replacement := fmt.Sprintf(
+			template,
+			PlaceholderModuleMigration,
+			opts.FromVersion,
+			opts.ToVersion(),
+			opts.ModuleName,
+		)
+		content = replacer.Replace(content, PlaceholderModuleMigration, replacement)
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}
+
+// keeperModify adds the migration method to the module migrator
+func keeperModify(replacer placeholder.Replacer, opts *Options) genny.RunFn {
+	return func(r *genny.Runner) error {
+		path := filepath.Join(opts.AppPath, "x", opts.ModuleName, "keeper/migrations.go")
+		f, err := r.Disk.Find(path)
+		if err != nil {
+			return err
+		}
+
+		// Import the migration package
+		old := "import ("
+		new := fmt.Sprintf(`%[1]v
+	%[2]v "%[3]v/x/%[4]v/migrations/%[2]v"`,
+			old,
+			opts.MigrationPackage(),
+			opts.ModulePath,
+			opts.ModuleName,
+		)
+		content := replacer.Replace(f.String(), old, new)
+
+		template := `// Migrate%[2]vto%[3]v migrates the store from consensus version %[2]v to %[3]v.
+func (m Migrator) Migrate%[2]vto%[3]v(ctx sdk.Context) error {
+	return %[4]v.MigrateStore(ctx, m.keeper.storeKey, m.keeper.cdc)
+}
+
+%[1]v`
+		replacement := fmt.Sprintf(
+			template,
+			PlaceholderKeeperMigrator,
+			opts.FromVersion,
+			opts.ToVersion(),
+			opts.MigrationPackage(),
+		)
+		content = replacer.Replace(content, PlaceholderKeeperMigrator, replacement)
+
+		newFile := genny.NewFileS(path, content)
+		return r.File(newFile)
+	}
+}